@@ -1,86 +1,313 @@
 package querybuilder
 
 import (
-    "fmt"
-    "strings"
+	"errors"
+	"fmt"
+	"strings"
 )
 
 type QueryBuilder struct {
-    baseQuery   string
-    conditions  []string
-    operators   []string
-    args        []any
-    values      []any
-    argCounter  int
-    limitValue  int
-    offsetValue int
-    sortFields  []SortField
+	statement
+	whereClause
+	selection   selectClause
+	groupFields []string
+	having      whereClause
+	cursor      []any
+	baseQuery   string
+	args        []any
+	limitValue  int
+	offsetValue int
+	sortFields  []SortField
+}
+
+// statement holds the dialect settings, bound values and collected errors
+// shared by every statement builder.
+type statement struct {
+	dialect      Dialect
+	placeholders PlaceholderStyle
+	quoteIdents  bool
+	paramLimit   int
+	limits       limitOptions
+	values       []any
+	argCounter   int
+	errs         []error
 }
 
 type QueryCondition struct {
-    condition   string
-    value       any
-    placeholder string
-    isGroup     bool
-    groupConds  []QueryCondition
-    groupOp     string
+	condition    string
+	negCondition string
+	negated      bool
+	column       string
+	otherColumn  string
+	value        any
+	inline       bool
+	pattern      []string
+	dateOnly     bool
+	rawParts     []string
+	placeholder  string
+	isGroup      bool
+	groupConds   []QueryCondition
+	groupOp      string
+	err          error
 }
 
 type SortField struct {
-    field     string
-    direction SortDirection
+	field     string
+	direction SortDirection
+	nulls     nullsOrder
+	priority  []any
+	err       error
+}
+
+// Option configures a QueryBuilder or any other statement builder.
+type Option func(*statement)
+
+// WithDialect sets the SQL dialect used to render placeholders, quoted
+// identifiers and pagination. Defaults to Postgres.
+func WithDialect(dialect Dialect) Option {
+	return func(st *statement) {
+		st.dialect = dialect
+	}
+}
+
+// WithPlaceholders overrides the placeholder style of the dialect,
+// e.g. to use $n placeholders with SQLite or :n placeholders.
+func WithPlaceholders(style PlaceholderStyle) Option {
+	return func(st *statement) {
+		st.placeholders = style
+	}
 }
 
-func NewQueryBuilder(query string) *QueryBuilder {
-    return &QueryBuilder{
-        baseQuery:   strings.TrimSpace(query),
-        conditions:  []string{},
-        operators:   []string{},
-        args:        []any{},
-        values:      []any{},
-        argCounter:  1,
-        limitValue:  -1,
-        offsetValue: -1,
-        sortFields:  []SortField{},
-    }
+// WithQuotedIdentifiers quotes column names using the dialect's quoting rules.
+func WithQuotedIdentifiers() Option {
+	return func(st *statement) {
+		st.quoteIdents = true
+	}
 }
 
+// WithMaxParams overrides the dialect's limit of bound parameters per
+// statement, e.g. for drivers with a lower limit than the database.
+func WithMaxParams(n int) Option {
+	return func(st *statement) {
+		st.paramLimit = n
+	}
+}
+
+func NewQueryBuilder(query string, opts ...Option) *QueryBuilder {
+	return &QueryBuilder{
+		statement:   newStatement(opts),
+		baseQuery:   strings.TrimSpace(query),
+		args:        []any{},
+		limitValue:  -1,
+		offsetValue: -1,
+		sortFields:  []SortField{},
+	}
+}
+
+// Build returns the final query and its values. Invalid column names,
+// unsupported values and other problems found while adding conditions or
+// sort fields are collected on the builder and returned here.
+func (qb *QueryBuilder) Build() (string, []any, error) {
+	if len(qb.errs) > 0 {
+		return "", nil, errors.Join(qb.errs...)
+	}
+
+	// Conditions are rendered on every build so placeholders are always
+	// numbered from the start
+	qb.reset()
+
+	query, err := qb.renderQuery(qb.selection, true)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if len(qb.sortFields) > 0 {
+		var sortParts []string
+		for _, field := range qb.sortFields {
+			sortParts = append(sortParts, qb.renderSort(field))
+		}
+		query += " ORDER BY " + strings.Join(sortParts, ", ")
+	}
+
+	limit, err := qb.effectiveLimit()
+	if err != nil {
+		return "", nil, err
+	}
+
+	query += qb.dialect.LimitOffset(limit, qb.offsetValue, len(qb.sortFields) > 0)
+
+	return query + ";", qb.values, nil
+}
+
+// MustBuild is like Build but panics if the builder collected any errors.
+func (qb *QueryBuilder) MustBuild() (string, []any) {
+	query, values, err := qb.Build()
+	if err != nil {
+		panic(err)
+	}
+	return query, values
+}
+
+// Commit is equivalent to MustBuild and is kept for existing callers.
+// Prefer Build when column names or values come from user input.
 func (qb *QueryBuilder) Commit() (string, []any) {
-    query := qb.baseQuery
-
-    if len(qb.conditions) > 0 {
-        whereClause := " WHERE " + qb.conditions[0]
-        for i := 1; i < len(qb.conditions); i++ {
-            whereClause += " " + qb.operators[i] + " " + qb.conditions[i]
-        }
-        query += whereClause
-    }
-
-    if len(qb.sortFields) > 0 {
-        var sortParts []string
-        for _, field := range qb.sortFields {
-            sortStr := field.field
-            if field.direction == SortDesc {
-                sortStr += " DESC"
-            }
-            sortParts = append(sortParts, sortStr)
-        }
-        query += " ORDER BY " + strings.Join(sortParts, ", ")
-    }
-
-    // Apply default limit of 10 if offset is set but limit is not
-    limitToApply := qb.limitValue
-    if qb.offsetValue >= 0 && qb.limitValue < 0 {
-        limitToApply = 10
-    }
-
-    if limitToApply >= 0 {
-        query += fmt.Sprintf(" LIMIT %d", limitToApply)
-    }
-
-    if qb.offsetValue >= 0 {
-        query += fmt.Sprintf(" OFFSET %d", qb.offsetValue)
-    }
-
-    return query + ";", qb.values
+	return qb.MustBuild()
+}
+
+// CountQuery returns a query counting the rows Build would return without
+// its sorting, limit, offset and keyset cursor, with the same WHERE and HAVING values. A
+// base query or a grouped query is wrapped as a subquery; a structured
+// select is counted directly.
+func (qb *QueryBuilder) CountQuery() (string, []any, error) {
+	if len(qb.errs) > 0 {
+		return "", nil, errors.Join(qb.errs...)
+	}
+
+	qb.reset()
+
+	if qb.selection.table.name != "" && len(qb.groupFields) == 0 && len(qb.having.conditions) == 0 {
+		count := qb.selection
+		count.columns = []selectColumn{{name: "COUNT(*)"}}
+
+		query, err := qb.renderQuery(count, false)
+		if err != nil {
+			return "", nil, err
+		}
+		return query + ";", qb.values, nil
+	}
+
+	query, err := qb.renderQuery(qb.selection, false)
+	if err != nil {
+		return "", nil, err
+	}
+	return "SELECT COUNT(*) FROM (" + query + ") AS count_query;", qb.values, nil
+}
+
+// renderQuery renders the query up to HAVING, selecting from s when it is a
+// structured select and from the base query otherwise. keyset adds the
+// keyset pagination predicate to the WHERE clause.
+func (qb *QueryBuilder) renderQuery(s selectClause, keyset bool) (string, error) {
+	query := qb.baseQuery
+	if s.table.name != "" {
+		query = qb.renderSelect(s)
+	} else if len(s.columns) > 0 || len(s.joins) > 0 {
+		return "", fmt.Errorf("%w: Select and Join need From", ErrInvalidStatement)
+	}
+
+	if keyset && qb.cursor != nil {
+		where, err := qb.renderKeysetWhere()
+		if err != nil {
+			return "", err
+		}
+		query += where
+	} else {
+		query += qb.renderWhere(qb.whereClause)
+	}
+
+	if len(qb.groupFields) > 0 {
+		groups := make([]string, len(qb.groupFields))
+		for i, field := range qb.groupFields {
+			groups[i] = qb.ident(field)
+		}
+		query += " GROUP BY " + strings.Join(groups, ", ")
+	}
+
+	// HAVING values are bound after the WHERE values
+	if len(qb.having.conditions) > 0 {
+		query += " HAVING " + qb.renderConditions(qb.having)
+	}
+
+	return query, nil
+}
+
+func newStatement(opts []Option) statement {
+	st := statement{
+		values:     []any{},
+		argCounter: 1,
+		dialect:    Postgres,
+	}
+	for _, opt := range opts {
+		opt(&st)
+	}
+	return st
+}
+
+// Err returns the errors collected so far, joined into one, or nil.
+func (st *statement) Err() error {
+	return errors.Join(st.errs...)
+}
+
+// reset clears the bound values before a statement is rendered.
+func (st *statement) reset() {
+	st.values = []any{}
+	st.argCounter = 1
+}
+
+// bindValue binds a single value and returns its placeholder.
+func (st *statement) bindValue(value any) string {
+	placeholder := st.placeholder(st.argCounter)
+	st.values = append(st.values, value)
+	st.argCounter++
+	return placeholder
+}
+
+// placeholder returns the bind marker for the n-th argument.
+func (st *statement) placeholder(n int) string {
+	if st.placeholders != 0 {
+		return st.placeholders.Format(n)
+	}
+	return st.dialect.Placeholder(n)
+}
+
+// maxParams returns the parameter limit per statement.
+func (st *statement) maxParams() int {
+	if st.paramLimit > 0 {
+		return st.paramLimit
+	}
+	return st.dialect.MaxParams()
+}
+
+// returningColumns validates the columns of a RETURNING clause, collecting
+// an error when the dialect cannot return rows.
+func (st *statement) returningColumns(columns []string) []string {
+	if clause, _ := st.dialect.Returning("", columns); clause == "" && len(columns) > 0 {
+		st.errs = append(st.errs, fmt.Errorf("%w: RETURNING", ErrUnsupportedByDialect))
+		return nil
+	}
+
+	valid := []string{}
+	for _, column := range columns {
+		if err := validateColumnName(column); err != nil {
+			st.errs = append(st.errs, err)
+			continue
+		}
+		valid = append(valid, column)
+	}
+	return valid
+}
+
+// returningClause renders the RETURNING (or OUTPUT) clause for columns.
+func (st *statement) returningClause(statement string, columns []string) (string, bool) {
+	if len(columns) == 0 {
+		return "", false
+	}
+
+	idents := make([]string, len(columns))
+	for i, column := range columns {
+		idents[i] = st.ident(column)
+	}
+	return st.dialect.Returning(statement, idents)
+}
+
+// ident renders a validated column name or function of one, quoting the
+// column when enabled.
+func (st *statement) ident(name string) string {
+	if !st.quoteIdents {
+		return name
+	}
+
+	if m := functionRegex.FindStringSubmatch(name); m != nil {
+		return m[1] + "(" + st.dialect.QuoteIdent(m[2]) + ")"
+	}
+	return st.dialect.QuoteIdent(name)
 }
@@ -2,154 +2,277 @@ package querybuilder
 
 import (
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 )
 
+var markerRegex = regexp.MustCompile(`\$(\d+)`)
+
+// whereClause holds the top-level conditions of a statement and the
+// connector written before each of them.
+type whereClause struct {
+	conditions []QueryCondition
+	operators  []string
+}
+
 func (qb *QueryBuilder) Where(conditions ...QueryCondition) *QueryBuilder {
+	qb.addConditions(&qb.whereClause, "AND", conditions)
+	return qb
+}
+
+// AndWhere is an alias of Where that reads better next to OrWhere.
+func (qb *QueryBuilder) AndWhere(conditions ...QueryCondition) *QueryBuilder {
+	qb.addConditions(&qb.whereClause, "AND", conditions)
+	return qb
+}
+
+// OrWhere adds conditions joined to the previous ones with OR. Connectors are
+// written in order and follow SQL precedence (AND binds tighter than OR), so
+// Where(a).OrWhere(b).Where(c) renders "a OR b AND c"; use Or/And to group.
+func (qb *QueryBuilder) OrWhere(conditions ...QueryCondition) *QueryBuilder {
+	qb.addConditions(&qb.whereClause, "OR", conditions)
+	return qb
+}
+
+// addConditions adds WHERE, ON or DO UPDATE conditions, which cannot compare
+// aggregates.
+func (st *statement) addConditions(w *whereClause, op string, conditions []QueryCondition) {
+	st.appendConditions(w, op, conditions, false)
+}
+
+// appendConditions adds conditions to w. aggregates reports whether they may
+// compare aggregates, which is only valid in HAVING.
+func (st *statement) appendConditions(w *whereClause, op string, conditions []QueryCondition, aggregates bool) {
 	for _, cond := range conditions {
-		if cond.isGroup {
-			var groupParts []string
-			for _, groupCond := range cond.groupConds {
-				// Expand slice values for IN clauses; otherwise single placeholder
-				if strings.Contains(groupCond.condition, "IN $1") {
-					switch v := groupCond.value.(type) {
-					case []int:
-						placeholders := make([]string, len(v))
-						for i := range v {
-							placeholders[i] = fmt.Sprintf("$%d", qb.argCounter+i)
-						}
-						expanded := strings.Replace(groupCond.condition, "IN $1", "IN ("+strings.Join(placeholders, ", ")+")", 1)
-						groupParts = append(groupParts, expanded)
-						for _, item := range v {
-							qb.values = append(qb.values, item)
-						}
-						qb.argCounter += len(v)
-					case []string:
-						placeholders := make([]string, len(v))
-						for i := range v {
-							placeholders[i] = fmt.Sprintf("$%d", qb.argCounter+i)
-						}
-						expanded := strings.Replace(groupCond.condition, "IN $1", "IN ("+strings.Join(placeholders, ", ")+")", 1)
-						groupParts = append(groupParts, expanded)
-						for _, item := range v {
-							qb.values = append(qb.values, item)
-						}
-						qb.argCounter += len(v)
-					default:
-						tempPlaceholder := fmt.Sprintf("$%d", qb.argCounter)
-						condition := strings.Replace(groupCond.condition, "$1", tempPlaceholder, 1)
-						groupParts = append(groupParts, condition)
-						qb.values = append(qb.values, groupCond.value)
-						qb.argCounter++
-					}
-				} else {
-					// Handle date range queries with []string values (two placeholders)
-					if v, ok := groupCond.value.([]string); ok && (strings.Contains(groupCond.condition, "$1") && strings.Contains(groupCond.condition, "$2")) {
-						placeholder1 := fmt.Sprintf("$%d", qb.argCounter)
-						placeholder2 := fmt.Sprintf("$%d", qb.argCounter+1)
-						condition := strings.Replace(groupCond.condition, "$1", placeholder1, 1)
-						condition = strings.Replace(condition, "$2", placeholder2, 1)
-						groupParts = append(groupParts, condition)
-						for _, item := range v {
-							qb.values = append(qb.values, item)
-						}
-						qb.argCounter += len(v)
-					} else {
-						tempPlaceholder := fmt.Sprintf("$%d", qb.argCounter)
-						condition := strings.Replace(groupCond.condition, "$1", tempPlaceholder, 1)
-						groupParts = append(groupParts, condition)
-						qb.values = append(qb.values, groupCond.value)
-						qb.argCounter++
-					}
-				}
-			}
-			groupCondition := "(" + strings.Join(groupParts, " "+cond.groupOp+" ") + ")"
-			qb.conditions = append(qb.conditions, groupCondition)
-			qb.operators = append(qb.operators, "AND")
-		} else {
-			if strings.Contains(cond.condition, "IN $1") {
-				switch v := cond.value.(type) {
-				case []int:
-					placeholders := make([]string, len(v))
-					for i := range v {
-						placeholders[i] = fmt.Sprintf("$%d", qb.argCounter+i)
-					}
-					condition := strings.Replace(cond.condition, "IN $1", "IN ("+strings.Join(placeholders, ", ")+")", 1)
-					qb.conditions = append(qb.conditions, condition)
-					qb.operators = append(qb.operators, "AND")
-					for _, item := range v {
-						qb.values = append(qb.values, item)
-					}
-					qb.argCounter += len(v)
-				case []string:
-					placeholders := make([]string, len(v))
-					for i := range v {
-						placeholders[i] = fmt.Sprintf("$%d", qb.argCounter+i)
-					}
-					condition := strings.Replace(cond.condition, "IN $1", "IN ("+strings.Join(placeholders, ", ")+")", 1)
-					qb.conditions = append(qb.conditions, condition)
-					qb.operators = append(qb.operators, "AND")
-					for _, item := range v {
-						qb.values = append(qb.values, item)
-					}
-					qb.argCounter += len(v)
-				default:
-					tempPlaceholder := fmt.Sprintf("$%d", qb.argCounter)
-					condition := strings.Replace(cond.condition, "$1", tempPlaceholder, 1)
-					qb.conditions = append(qb.conditions, condition)
-					qb.operators = append(qb.operators, "AND")
-					qb.values = append(qb.values, cond.value)
-					qb.argCounter++
-				}
-			} else {
-				// Handle date range queries with []string values (two placeholders)
-				if v, ok := cond.value.([]string); ok && (strings.Contains(cond.condition, "$1") && strings.Contains(cond.condition, "$2")) {
-					placeholder1 := fmt.Sprintf("$%d", qb.argCounter)
-					placeholder2 := fmt.Sprintf("$%d", qb.argCounter+1)
-					condition := strings.Replace(cond.condition, "$1", placeholder1, 1)
-					condition = strings.Replace(condition, "$2", placeholder2, 1)
-					qb.conditions = append(qb.conditions, condition)
-					qb.operators = append(qb.operators, "AND")
-					for _, item := range v {
-						qb.values = append(qb.values, item)
-					}
-					qb.argCounter += len(v)
-				} else {
-					tempPlaceholder := fmt.Sprintf("$%d", qb.argCounter)
-					condition := strings.Replace(cond.condition, "$1", tempPlaceholder, 1)
-					qb.conditions = append(qb.conditions, condition)
-					qb.operators = append(qb.operators, "AND")
-					qb.values = append(qb.values, cond.value)
-					qb.argCounter++
-				}
-			}
+		// Empty conditions (e.g. a matcher given no values) are optional filters
+		if cond.isEmpty() {
+			continue
+		}
+
+		if err := cond.Err(); err != nil {
+			st.errs = append(st.errs, err)
+			continue
 		}
+
+		if column := cond.aggregate(); column != "" && !aggregates {
+			st.errs = append(st.errs, fmt.Errorf("%w: aggregate %s is only allowed in HAVING", ErrInvalidStatement, column))
+			continue
+		}
+
+		w.conditions = append(w.conditions, cond)
+		w.operators = append(w.operators, op)
 	}
-	return qb
 }
 
-func Or(conditions ...QueryCondition) QueryCondition {
-	return QueryCondition{
-		isGroup:    true,
-		groupConds: conditions,
-		groupOp:    "OR",
+// renderWhere renders the WHERE clause with its leading space, or nothing
+// when there are no conditions.
+func (st *statement) renderWhere(w whereClause) string {
+	if len(w.conditions) == 0 {
+		return ""
+	}
+	return " WHERE " + st.renderConditions(w)
+}
+
+// renderConditions renders the conditions joined by their connectors.
+func (st *statement) renderConditions(w whereClause) string {
+	clause := st.render(w.conditions[0])
+	for i := 1; i < len(w.conditions); i++ {
+		clause += " " + w.operators[i] + " " + st.render(w.conditions[i])
+	}
+	return clause
+}
+
+// render renders a condition, recursing into groups of any depth. Groups are
+// parenthesised and their placeholders are numbered in the order they appear.
+func (st *statement) render(cond QueryCondition) string {
+	if !cond.isGroup {
+		condition := st.bind(cond)
+		switch {
+		case cond.negated && cond.rawParts != nil:
+			return "NOT " + condition
+		case cond.negated:
+			return "NOT (" + condition + ")"
+		default:
+			return condition
+		}
+	}
+
+	parts := make([]string, len(cond.groupConds))
+	for i, groupCond := range cond.groupConds {
+		parts[i] = st.render(groupCond)
+	}
+
+	condition := "(" + strings.Join(parts, " "+cond.groupOp+" ") + ")"
+	if cond.negated {
+		return "NOT " + condition
+	}
+	return condition
+}
+
+// bind renders a single condition, replacing its local $n markers with the
+// builder's placeholders and collecting its values.
+func (st *statement) bind(cond QueryCondition) string {
+	if cond.rawParts != nil {
+		return st.bindRaw(cond)
+	}
+
+	condition := cond.condition
+	if cond.pattern != nil {
+		condition = fmt.Sprintf(condition, st.ident(cond.column), st.dialect.Concat(cond.pattern...))
+	} else if cond.dateOnly {
+		condition = fmt.Sprintf(condition, st.dialect.Date(st.ident(cond.column)), st.dialect.Date("$1"))
+	} else if cond.otherColumn != "" {
+		condition = fmt.Sprintf(condition, st.ident(cond.column), st.ident(cond.otherColumn))
+	} else if cond.column != "" {
+		condition = fmt.Sprintf(condition, st.ident(cond.column))
+	}
+	// Inline values are rendered as literals by the dialect and bind nothing
+	if cond.inline {
+		literal := st.dialect.Bool(cond.value.(bool))
+		return markerRegex.ReplaceAllLiteralString(condition, literal)
+	}
+
+	values := conditionValues(cond.value)
+
+	// Expand slice values for IN clauses; otherwise one placeholder per marker
+	if strings.Contains(condition, "IN $1") {
+		placeholders := make([]string, len(values))
+		for i := range values {
+			placeholders[i] = st.placeholder(st.argCounter + i)
+		}
+		condition = strings.Replace(condition, "IN $1", "IN ("+strings.Join(placeholders, ", ")+")", 1)
+	} else {
+		base := st.argCounter
+		condition = markerRegex.ReplaceAllStringFunc(condition, func(marker string) string {
+			n, _ := strconv.Atoi(marker[1:])
+			return st.placeholder(base + n - 1)
+		})
+	}
+
+	st.values = append(st.values, values...)
+	st.argCounter += len(values)
+	return condition
+}
+
+// boundValues holds the arguments of a condition with several placeholders,
+// such as an IN list or a range. It is distinct from []any so a single value
+// that happens to be a []any binds as one argument.
+type boundValues []any
+
+// conditionValues flattens a condition value into its bound arguments.
+// Matchers store IN lists and ranges as boundValues, which bind one argument
+// per item; a nil value (e.g. IS NULL) binds none.
+func conditionValues(value any) []any {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case boundValues:
+		return v
+	default:
+		return []any{v}
+	}
+}
+
+// Err returns the error recorded while building the condition or any of its
+// grouped conditions, or nil.
+func (c QueryCondition) Err() error {
+	if c.err != nil {
+		return c.err
 	}
+	for _, groupCond := range c.groupConds {
+		if err := groupCond.Err(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// aggregate returns the first aggregate the condition or its group compares,
+// or "" when there is none.
+func (c QueryCondition) aggregate() string {
+	if isAggregate(c.column) {
+		return c.column
+	}
+	for _, groupCond := range c.groupConds {
+		if column := groupCond.aggregate(); column != "" {
+			return column
+		}
+	}
+	return ""
+}
+
+func Or(conditions ...QueryCondition) QueryCondition {
+	return group("OR", conditions)
 }
 
 func And(conditions ...QueryCondition) QueryCondition {
+	return group("AND", conditions)
+}
+
+// Not negates a condition or group. Equality, IN and LIKE conditions render
+// their negated operator directly (<>, NOT IN, NOT LIKE); anything else is
+// wrapped in NOT (...). Negating twice restores the original condition.
+func Not(cond QueryCondition) QueryCondition {
+	if cond.isEmpty() || cond.err != nil {
+		return cond
+	}
+
+	if cond.negCondition != "" {
+		cond.condition, cond.negCondition = cond.negCondition, cond.condition
+		return cond
+	}
+
+	cond.negated = !cond.negated
+	return cond
+}
+
+// group joins the non-empty conditions with op. A group without any
+// non-empty member is itself empty and is dropped by Where.
+func group(op string, conditions []QueryCondition) QueryCondition {
+	var members []QueryCondition
+	for _, cond := range conditions {
+		if !cond.isEmpty() {
+			members = append(members, cond)
+		}
+	}
+
+	if len(members) == 0 {
+		return QueryCondition{}
+	}
+
 	return QueryCondition{
 		isGroup:    true,
-		groupConds: conditions,
-		groupOp:    "AND",
+		groupConds: members,
+		groupOp:    op,
 	}
 }
 
+// isEmpty reports whether the condition is a no-op.
+func (c QueryCondition) isEmpty() bool {
+	return c.err == nil && !c.isGroup && c.condition == ""
+}
+
+// Limit sets the maximum number of rows. A negative limit is reported as
+// ErrInvalidLimit and one above WithMaxLimit is clamped or reported.
 func (qb *QueryBuilder) Limit(limit int) *QueryBuilder {
+	if limit < 0 {
+		qb.errs = append(qb.errs, fmt.Errorf("%w: negative limit %d", ErrInvalidLimit, limit))
+		return qb
+	}
+
 	qb.limitValue = limit
 	return qb
 }
 
+// Offset sets the number of rows to skip. Without a limit, the default limit
+// is applied. A negative offset is reported as ErrInvalidLimit.
 func (qb *QueryBuilder) Offset(offset int) *QueryBuilder {
+	if offset < 0 {
+		qb.errs = append(qb.errs, fmt.Errorf("%w: negative offset %d", ErrInvalidLimit, offset))
+		return qb
+	}
+
 	qb.offsetValue = offset
 	return qb
 }
@@ -0,0 +1,129 @@
+package querybuilder
+
+import "fmt"
+
+// DeleteBuilder builds DELETE statements filtered with the same conditions
+// as QueryBuilder.Where.
+type DeleteBuilder struct {
+	statement
+	whereClause
+	table      string
+	returning  []string
+	limitValue int
+	allRows    bool
+}
+
+// Delete starts a DELETE statement for table. Placeholders, quoting and
+// RETURNING follow the dialect set with WithDialect, the same as NewQueryBuilder.
+func Delete(table string, opts ...Option) *DeleteBuilder {
+	db := &DeleteBuilder{
+		statement:  newStatement(opts),
+		table:      table,
+		returning:  []string{},
+		limitValue: -1,
+	}
+
+	if err := validateColumnName(table); err != nil {
+		db.errs = append(db.errs, err)
+	}
+	return db
+}
+
+func (db *DeleteBuilder) Where(conditions ...QueryCondition) *DeleteBuilder {
+	db.addConditions(&db.whereClause, "AND", conditions)
+	return db
+}
+
+// AndWhere is an alias of Where that reads better next to OrWhere.
+func (db *DeleteBuilder) AndWhere(conditions ...QueryCondition) *DeleteBuilder {
+	db.addConditions(&db.whereClause, "AND", conditions)
+	return db
+}
+
+// OrWhere adds conditions joined to the previous ones with OR.
+func (db *DeleteBuilder) OrWhere(conditions ...QueryCondition) *DeleteBuilder {
+	db.addConditions(&db.whereClause, "OR", conditions)
+	return db
+}
+
+// AllRows allows the statement to be built without any WHERE condition.
+func (db *DeleteBuilder) AllRows() *DeleteBuilder {
+	db.allRows = true
+	return db
+}
+
+// Returning sets the columns returned for each deleted row. SQL Server
+// renders an OUTPUT clause; MySQL has no equivalent and reports an error.
+func (db *DeleteBuilder) Returning(columns ...string) *DeleteBuilder {
+	db.returning = append(db.returning, db.returningColumns(columns)...)
+	return db
+}
+
+// Limit caps the number of deleted rows. MySQL renders LIMIT and SQL Server
+// renders TOP; PostgreSQL and SQLite report ErrUnsupportedByDialect. A
+// negative limit is reported as ErrInvalidLimit.
+func (db *DeleteBuilder) Limit(limit int) *DeleteBuilder {
+	if limit < 0 {
+		db.errs = append(db.errs, fmt.Errorf("%w: negative limit %d", ErrInvalidLimit, limit))
+		return db
+	}
+
+	if clause, _ := db.dialect.DeleteLimit(limit); clause == "" {
+		db.errs = append(db.errs, fmt.Errorf("%w: DELETE with LIMIT", ErrUnsupportedByDialect))
+		return db
+	}
+
+	db.limitValue = limit
+	return db
+}
+
+// Build returns the DELETE statement and its values. A DELETE without
+// conditions fails with ErrMissingWhere unless AllRows was called; empty
+// conditions do not count, so optional filters cannot widen it to every row.
+func (db *DeleteBuilder) Build() (string, []any, error) {
+	if err := db.Err(); err != nil {
+		return "", nil, err
+	}
+
+	if len(db.conditions) == 0 && !db.allRows {
+		return "", nil, fmt.Errorf("%w: delete from %s would remove every row, call AllRows to allow it", ErrMissingWhere, db.table)
+	}
+
+	db.reset()
+
+	var limit string
+	var top bool
+	if db.limitValue >= 0 {
+		limit, top = db.dialect.DeleteLimit(db.limitValue)
+	}
+
+	query := "DELETE"
+	if top {
+		query += limit
+	}
+	query += " FROM " + db.ident(db.table)
+
+	returning, output := db.returningClause("DELETE", db.returning)
+	if output {
+		query += returning
+	}
+
+	query += db.renderWhere(db.whereClause)
+
+	if !output {
+		query += returning
+	}
+	if !top {
+		query += limit
+	}
+	return query + ";", db.values, nil
+}
+
+// MustBuild is like Build but panics if the statement cannot be built.
+func (db *DeleteBuilder) MustBuild() (string, []any) {
+	query, values, err := db.Build()
+	if err != nil {
+		panic(err)
+	}
+	return query, values
+}
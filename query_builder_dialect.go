@@ -0,0 +1,414 @@
+package querybuilder
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Dialect renders the database specific parts of a query: bind
+// placeholders, quoted identifiers, string concatenation, boolean literals,
+// dates, the pagination clause and statement capabilities such as RETURNING.
+type Dialect interface {
+	// Placeholder returns the bind marker for the n-th argument (1-based).
+	Placeholder(n int) string
+	// QuoteIdent quotes an identifier, quoting each part of a dotted name.
+	QuoteIdent(name string) string
+	// LimitOffset renders the pagination clause, including its leading space.
+	// A negative limit or offset means the value is not set. sorted reports
+	// whether the query already has an ORDER BY clause.
+	LimitOffset(limit, offset int, sorted bool) string
+	// Concat renders a string concatenation of the given expressions.
+	Concat(parts ...string) string
+	// Bool renders a boolean literal.
+	Bool(value bool) string
+	// Date renders the date part of a timestamp expression.
+	Date(expr string) string
+	// MaxParams returns the maximum number of bound parameters per statement.
+	MaxParams() int
+	// MaxInsertRows returns the maximum number of rows per INSERT, or 0 when
+	// only the parameter limit applies.
+	MaxInsertRows() int
+	// Returning renders the clause returning columns of the rows affected by
+	// an INSERT, UPDATE or DELETE statement. output reports whether it is an
+	// OUTPUT clause placed before VALUES/WHERE instead of at the end of the
+	// statement. An empty clause means the dialect cannot return rows.
+	Returning(statement string, columns []string) (clause string, output bool)
+	// DeleteLimit renders the clause limiting the rows removed by a DELETE.
+	// top reports whether it follows the DELETE keyword instead of ending the
+	// statement. An empty clause means the dialect cannot limit a DELETE.
+	DeleteLimit(limit int) (clause string, top bool)
+	// OnConflict renders the ON CONFLICT clause of an upsert for the already
+	// quoted target columns, without its action. An empty clause means the
+	// dialect has no ON CONFLICT support.
+	OnConflict(target []string) string
+	// RowValues reports whether row values can be compared, as in
+	// (a, b) > ($1, $2).
+	RowValues() bool
+	// FullJoin reports whether FULL JOIN is supported.
+	FullJoin() bool
+	// NullsOrder renders the NULLS FIRST/LAST clause of a sort field. An
+	// empty clause means the dialect has no native support and the order is
+	// emulated.
+	NullsOrder(first bool) string
+}
+
+// PlaceholderStyle is the bind marker format used for query arguments.
+type PlaceholderStyle int
+
+const (
+	PlaceholderDollar   PlaceholderStyle = iota + 1 // $1, $2, ... (PostgreSQL)
+	PlaceholderQuestion                             // ?, ?, ... (MySQL, SQLite)
+	PlaceholderAtP                                  // @p1, @p2, ... (SQL Server)
+	PlaceholderColon                                // :1, :2, ... (Oracle)
+)
+
+// Format returns the bind marker for the n-th argument (1-based).
+func (s PlaceholderStyle) Format(n int) string {
+	switch s {
+	case PlaceholderQuestion:
+		return "?"
+	case PlaceholderAtP:
+		return fmt.Sprintf("@p%d", n)
+	case PlaceholderColon:
+		return fmt.Sprintf(":%d", n)
+	default:
+		return fmt.Sprintf("$%d", n)
+	}
+}
+
+var (
+	// Postgres renders $n placeholders, "double quoted" identifiers and LIMIT/OFFSET.
+	Postgres Dialect = postgresDialect{}
+	// MySQL renders ? placeholders, `backtick quoted` identifiers and LIMIT/OFFSET.
+	MySQL Dialect = mysqlDialect{}
+	// SQLite renders ? placeholders, "double quoted" identifiers and LIMIT/OFFSET.
+	SQLite Dialect = sqliteDialect{}
+	// SQLServer renders @pN placeholders, [bracket quoted] identifiers and
+	// OFFSET ... ROWS FETCH NEXT ... ROWS ONLY.
+	SQLServer Dialect = sqlServerDialect{}
+)
+
+type postgresDialect struct{}
+
+func (postgresDialect) Placeholder(n int) string {
+	return PlaceholderDollar.Format(n)
+}
+
+func (postgresDialect) QuoteIdent(name string) string {
+	return quoteIdent(name, `"`, `"`)
+}
+
+func (postgresDialect) LimitOffset(limit, offset int, sorted bool) string {
+	return limitOffset(limit, offset)
+}
+
+func (postgresDialect) Concat(parts ...string) string {
+	return strings.Join(parts, " || ")
+}
+
+func (postgresDialect) Bool(value bool) string {
+	return boolLiteral(value)
+}
+
+func (postgresDialect) Date(expr string) string {
+	return "DATE(" + expr + ")"
+}
+
+func (postgresDialect) MaxParams() int {
+	return 65535
+}
+
+func (postgresDialect) MaxInsertRows() int {
+	return 0
+}
+
+func (postgresDialect) Returning(statement string, columns []string) (string, bool) {
+	return returning(columns), false
+}
+
+// DeleteLimit is not supported by PostgreSQL.
+func (postgresDialect) DeleteLimit(limit int) (string, bool) {
+	return "", false
+}
+
+func (postgresDialect) OnConflict(target []string) string {
+	return onConflict(target)
+}
+
+func (postgresDialect) RowValues() bool {
+	return true
+}
+
+func (postgresDialect) FullJoin() bool {
+	return true
+}
+
+func (postgresDialect) NullsOrder(first bool) string {
+	return nullsClause(first)
+}
+
+type mysqlDialect struct{}
+
+func (mysqlDialect) Placeholder(n int) string {
+	return PlaceholderQuestion.Format(n)
+}
+
+func (mysqlDialect) QuoteIdent(name string) string {
+	return quoteIdent(name, "`", "`")
+}
+
+func (mysqlDialect) LimitOffset(limit, offset int, sorted bool) string {
+	return limitOffset(limit, offset)
+}
+
+func (mysqlDialect) Concat(parts ...string) string {
+	return "CONCAT(" + strings.Join(parts, ", ") + ")"
+}
+
+func (mysqlDialect) Bool(value bool) string {
+	return boolLiteral(value)
+}
+
+func (mysqlDialect) Date(expr string) string {
+	return "DATE(" + expr + ")"
+}
+
+func (mysqlDialect) MaxParams() int {
+	return 65535
+}
+
+func (mysqlDialect) MaxInsertRows() int {
+	return 0
+}
+
+// Returning is not supported by MySQL.
+func (mysqlDialect) Returning(statement string, columns []string) (string, bool) {
+	return "", false
+}
+
+func (mysqlDialect) DeleteLimit(limit int) (string, bool) {
+	return fmt.Sprintf(" LIMIT %d", limit), false
+}
+
+// OnConflict is not supported by MySQL, whose ON DUPLICATE KEY UPDATE has no
+// conflict target or WHERE.
+func (mysqlDialect) OnConflict(target []string) string {
+	return ""
+}
+
+func (mysqlDialect) RowValues() bool {
+	return true
+}
+
+// FullJoin is false as MySQL has no FULL JOIN.
+func (mysqlDialect) FullJoin() bool {
+	return false
+}
+
+// NullsOrder is emulated, as MySQL has no NULLS FIRST/LAST.
+func (mysqlDialect) NullsOrder(first bool) string {
+	return ""
+}
+
+type sqliteDialect struct{}
+
+func (sqliteDialect) Placeholder(n int) string {
+	return PlaceholderQuestion.Format(n)
+}
+
+func (sqliteDialect) QuoteIdent(name string) string {
+	return quoteIdent(name, `"`, `"`)
+}
+
+func (sqliteDialect) LimitOffset(limit, offset int, sorted bool) string {
+	return limitOffset(limit, offset)
+}
+
+func (sqliteDialect) Concat(parts ...string) string {
+	return strings.Join(parts, " || ")
+}
+
+func (sqliteDialect) Bool(value bool) string {
+	return boolLiteral(value)
+}
+
+func (sqliteDialect) Date(expr string) string {
+	return "DATE(" + expr + ")"
+}
+
+// MaxParams returns SQLITE_MAX_VARIABLE_NUMBER for SQLite 3.32 and later.
+func (sqliteDialect) MaxParams() int {
+	return 32766
+}
+
+func (sqliteDialect) MaxInsertRows() int {
+	return 0
+}
+
+func (sqliteDialect) Returning(statement string, columns []string) (string, bool) {
+	return returning(columns), false
+}
+
+// DeleteLimit is not supported, as SQLite only allows it when compiled with
+// SQLITE_ENABLE_UPDATE_DELETE_LIMIT.
+func (sqliteDialect) DeleteLimit(limit int) (string, bool) {
+	return "", false
+}
+
+func (sqliteDialect) OnConflict(target []string) string {
+	return onConflict(target)
+}
+
+func (sqliteDialect) RowValues() bool {
+	return true
+}
+
+// FullJoin requires SQLite 3.39 or later.
+func (sqliteDialect) FullJoin() bool {
+	return true
+}
+
+// NullsOrder requires SQLite 3.30 or later.
+func (sqliteDialect) NullsOrder(first bool) string {
+	return nullsClause(first)
+}
+
+type sqlServerDialect struct{}
+
+func (sqlServerDialect) Placeholder(n int) string {
+	return PlaceholderAtP.Format(n)
+}
+
+func (sqlServerDialect) QuoteIdent(name string) string {
+	return quoteIdent(name, "[", "]")
+}
+
+func (sqlServerDialect) LimitOffset(limit, offset int, sorted bool) string {
+	if limit < 0 && offset < 0 {
+		return ""
+	}
+
+	// OFFSET/FETCH is only valid after an ORDER BY clause
+	var clause string
+	if !sorted {
+		clause = " ORDER BY (SELECT NULL)"
+	}
+
+	clause += fmt.Sprintf(" OFFSET %d ROWS", max(offset, 0))
+	if limit >= 0 {
+		clause += fmt.Sprintf(" FETCH NEXT %d ROWS ONLY", limit)
+	}
+	return clause
+}
+
+func (sqlServerDialect) Concat(parts ...string) string {
+	return strings.Join(parts, " + ")
+}
+
+// Bool renders 1 or 0 since SQL Server has no boolean literals; BIT columns
+// compare against integers.
+func (sqlServerDialect) Bool(value bool) string {
+	if value {
+		return "1"
+	}
+	return "0"
+}
+
+// Date casts to DATE, as SQL Server has no DATE() function.
+func (sqlServerDialect) Date(expr string) string {
+	return "CAST(" + expr + " AS DATE)"
+}
+
+func (sqlServerDialect) MaxParams() int {
+	return 2100
+}
+
+// MaxInsertRows returns the row limit of a table value constructor.
+func (sqlServerDialect) MaxInsertRows() int {
+	return 1000
+}
+
+// Returning renders an OUTPUT clause reading from the INSERTED pseudo table,
+// or DELETED for DELETE statements.
+func (sqlServerDialect) Returning(statement string, columns []string) (string, bool) {
+	table := "INSERTED"
+	if statement == "DELETE" {
+		table = "DELETED"
+	}
+
+	outputs := make([]string, len(columns))
+	for i, column := range columns {
+		outputs[i] = table + "." + column
+	}
+	return " OUTPUT " + strings.Join(outputs, ", "), true
+}
+
+func (sqlServerDialect) DeleteLimit(limit int) (string, bool) {
+	return fmt.Sprintf(" TOP (%d)", limit), true
+}
+
+// OnConflict is not supported by SQL Server, which upserts with MERGE.
+func (sqlServerDialect) OnConflict(target []string) string {
+	return ""
+}
+
+// RowValues is false as SQL Server has no row value comparisons.
+func (sqlServerDialect) RowValues() bool {
+	return false
+}
+
+func (sqlServerDialect) FullJoin() bool {
+	return true
+}
+
+// NullsOrder is emulated, as SQL Server has no NULLS FIRST/LAST.
+func (sqlServerDialect) NullsOrder(first bool) string {
+	return ""
+}
+
+func limitOffset(limit, offset int) string {
+	var clause string
+	if limit >= 0 {
+		clause += fmt.Sprintf(" LIMIT %d", limit)
+	}
+	if offset >= 0 {
+		clause += fmt.Sprintf(" OFFSET %d", offset)
+	}
+	return clause
+}
+
+func returning(columns []string) string {
+	return " RETURNING " + strings.Join(columns, ", ")
+}
+
+func onConflict(target []string) string {
+	if len(target) == 0 {
+		return " ON CONFLICT"
+	}
+	return " ON CONFLICT (" + strings.Join(target, ", ") + ")"
+}
+
+func nullsClause(first bool) string {
+	if first {
+		return " NULLS FIRST"
+	}
+	return " NULLS LAST"
+}
+
+func boolLiteral(value bool) string {
+	if value {
+		return "TRUE"
+	}
+	return "FALSE"
+}
+
+func quoteIdent(name, open, close string) string {
+	parts := strings.Split(name, ".")
+	for i, part := range parts {
+		if part == "*" {
+			continue
+		}
+		parts[i] = open + strings.ReplaceAll(part, close, close+close) + close
+	}
+	return strings.Join(parts, ".")
+}
@@ -0,0 +1,52 @@
+package querybuilder
+
+// GroupBy adds GROUP BY columns.
+func (qb *QueryBuilder) GroupBy(columns ...string) *QueryBuilder {
+	for _, column := range columns {
+		if err := validateColumnName(column); err != nil {
+			qb.errs = append(qb.errs, err)
+			continue
+		}
+		qb.groupFields = append(qb.groupFields, column)
+	}
+	return qb
+}
+
+// Having adds HAVING conditions joined with AND. Any matcher can be used
+// against an aggregate, e.g. ByIntComparison(Count("*"), OpGt, 5); its values
+// are bound after the WHERE values.
+func (qb *QueryBuilder) Having(conditions ...QueryCondition) *QueryBuilder {
+	qb.appendConditions(&qb.having, "AND", conditions, true)
+	return qb
+}
+
+// Count returns the COUNT(column) aggregate; use "*" to count rows.
+func Count(column string) string {
+	return sqlFunction("COUNT", column)
+}
+
+// Sum returns the SUM(column) aggregate.
+func Sum(column string) string {
+	return sqlFunction("SUM", column)
+}
+
+// Avg returns the AVG(column) aggregate.
+func Avg(column string) string {
+	return sqlFunction("AVG", column)
+}
+
+// Min returns the MIN(column) aggregate.
+func Min(column string) string {
+	return sqlFunction("MIN", column)
+}
+
+// Max returns the MAX(column) aggregate.
+func Max(column string) string {
+	return sqlFunction("MAX", column)
+}
+
+// sqlFunction renders a function of a column. Like a column name, it is
+// validated where it is used: in Select, matchers and Sort.
+func sqlFunction(function, column string) string {
+	return function + "(" + column + ")"
+}
@@ -0,0 +1,180 @@
+package querybuilder
+
+import (
+	"fmt"
+	"strings"
+)
+
+// InsertBuilder builds INSERT statements with one or more rows.
+type InsertBuilder struct {
+	statement
+	table     string
+	columns   []string
+	rows      [][]any
+	returning []string
+	conflict  upsert
+}
+
+// Batch is a single statement produced by BuildBatches.
+type Batch struct {
+	Query  string
+	Values []any
+}
+
+// Insert starts an INSERT statement for table. Placeholders, quoting and
+// RETURNING follow the dialect set with WithDialect, the same as NewQueryBuilder.
+func Insert(table string, opts ...Option) *InsertBuilder {
+	ib := &InsertBuilder{
+		statement: newStatement(opts),
+		table:     table,
+		columns:   []string{},
+		rows:      [][]any{},
+		returning: []string{},
+	}
+
+	if err := validateColumnName(table); err != nil {
+		ib.errs = append(ib.errs, err)
+	}
+	return ib
+}
+
+// Columns sets the columns every row provides values for.
+func (ib *InsertBuilder) Columns(columns ...string) *InsertBuilder {
+	for _, column := range columns {
+		if err := validateColumnName(column); err != nil {
+			ib.errs = append(ib.errs, err)
+			continue
+		}
+		ib.columns = append(ib.columns, column)
+	}
+	return ib
+}
+
+// Values adds a row. Call it once per row; values are bound in column order.
+func (ib *InsertBuilder) Values(values ...any) *InsertBuilder {
+	ib.rows = append(ib.rows, values)
+	return ib
+}
+
+// Returning sets the columns returned for each inserted row. SQL Server
+// renders an OUTPUT clause; MySQL has no equivalent and reports an error.
+func (ib *InsertBuilder) Returning(columns ...string) *InsertBuilder {
+	ib.returning = append(ib.returning, ib.returningColumns(columns)...)
+	return ib
+}
+
+// Build returns a single INSERT statement with all rows. It fails with
+// ErrTooManyParameters when the rows do not fit in one statement; use
+// BuildBatches to split them.
+func (ib *InsertBuilder) Build() (string, []any, error) {
+	size, err := ib.validate()
+	if err != nil {
+		return "", nil, err
+	}
+
+	if len(ib.rows) > size {
+		return "", nil, fmt.Errorf("%w: %d rows of %d columns exceed the limit of %d rows per statement, use BuildBatches",
+			ErrTooManyParameters, len(ib.rows), len(ib.columns), size)
+	}
+
+	return ib.render(ib.rows), ib.values, nil
+}
+
+// MustBuild is like Build but panics if the statement cannot be built.
+func (ib *InsertBuilder) MustBuild() (string, []any) {
+	query, values, err := ib.Build()
+	if err != nil {
+		panic(err)
+	}
+	return query, values
+}
+
+// BuildBatches splits the rows into as many INSERT statements as needed to
+// stay under the dialect's parameter and row limits. Each statement numbers
+// its placeholders from the start.
+func (ib *InsertBuilder) BuildBatches() ([]Batch, error) {
+	size, err := ib.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	batches := []Batch{}
+	for start := 0; start < len(ib.rows); start += size {
+		end := min(start+size, len(ib.rows))
+		query := ib.render(ib.rows[start:end])
+		batches = append(batches, Batch{Query: query, Values: ib.values})
+	}
+	return batches, nil
+}
+
+// validate checks the statement is complete and returns the maximum number
+// of rows per statement.
+func (ib *InsertBuilder) validate() (int, error) {
+	if err := ib.Err(); err != nil {
+		return 0, err
+	}
+
+	if len(ib.columns) == 0 {
+		return 0, fmt.Errorf("%w: insert into %s has no columns", ErrInvalidStatement, ib.table)
+	}
+
+	if len(ib.rows) == 0 {
+		return 0, fmt.Errorf("%w: insert into %s has no rows", ErrInvalidStatement, ib.table)
+	}
+
+	if err := ib.validateConflict(); err != nil {
+		return 0, err
+	}
+
+	for i, row := range ib.rows {
+		if len(row) != len(ib.columns) {
+			return 0, fmt.Errorf("%w: row %d has %d values for %d columns", ErrArgumentCount, i+1, len(row), len(ib.columns))
+		}
+	}
+
+	// Every statement also binds the values of the DO UPDATE conditions
+	ib.reset()
+	ib.renderWhere(ib.conflict.where)
+	params := ib.maxParams() - len(ib.values)
+
+	size := params / len(ib.columns)
+	if size <= 0 {
+		return 0, fmt.Errorf("%w: %d columns exceed the limit of %d parameters", ErrTooManyParameters, len(ib.columns), ib.maxParams())
+	}
+
+	if maxRows := ib.dialect.MaxInsertRows(); maxRows > 0 {
+		size = min(size, maxRows)
+	}
+	return size, nil
+}
+
+func (ib *InsertBuilder) render(rows [][]any) string {
+	ib.reset()
+
+	columns := make([]string, len(ib.columns))
+	for i, column := range ib.columns {
+		columns[i] = ib.ident(column)
+	}
+
+	query := "INSERT INTO " + ib.ident(ib.table) + " (" + strings.Join(columns, ", ") + ")"
+
+	returning, output := ib.returningClause("INSERT", ib.returning)
+	if output {
+		query += returning
+	}
+
+	tuples := make([]string, len(rows))
+	for i, row := range rows {
+		placeholders := make([]string, len(row))
+		for j, value := range row {
+			placeholders[j] = ib.bindValue(value)
+		}
+		tuples[i] = "(" + strings.Join(placeholders, ", ") + ")"
+	}
+	query += " VALUES " + strings.Join(tuples, ", ") + ib.renderConflict()
+
+	if !output {
+		query += returning
+	}
+	return query + ";"
+}
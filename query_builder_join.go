@@ -0,0 +1,87 @@
+package querybuilder
+
+import "fmt"
+
+// join is a joined table of a structured query and its ON conditions.
+type join struct {
+	kind  string
+	table selectColumn
+	on    whereClause
+}
+
+// Join adds an INNER JOIN to a structured query. The ON clause joins the
+// conditions with AND; use On for column-to-column comparisons and any
+// matcher for values, which are numbered before the WHERE values.
+func (qb *QueryBuilder) Join(table string, on ...QueryCondition) *QueryBuilder {
+	return qb.join("JOIN", table, on)
+}
+
+// LeftJoin adds a LEFT JOIN to a structured query.
+func (qb *QueryBuilder) LeftJoin(table string, on ...QueryCondition) *QueryBuilder {
+	return qb.join("LEFT JOIN", table, on)
+}
+
+// RightJoin adds a RIGHT JOIN to a structured query.
+func (qb *QueryBuilder) RightJoin(table string, on ...QueryCondition) *QueryBuilder {
+	return qb.join("RIGHT JOIN", table, on)
+}
+
+// FullJoin adds a FULL JOIN to a structured query. MySQL has no FULL JOIN
+// and reports ErrUnsupportedByDialect.
+func (qb *QueryBuilder) FullJoin(table string, on ...QueryCondition) *QueryBuilder {
+	if !qb.dialect.FullJoin() {
+		qb.errs = append(qb.errs, fmt.Errorf("%w: FULL JOIN", ErrUnsupportedByDialect))
+		return qb
+	}
+	return qb.join("FULL JOIN", table, on)
+}
+
+func (qb *QueryBuilder) join(kind, table string, on []QueryCondition) *QueryBuilder {
+	t, err := parseTable(table)
+	if err != nil {
+		qb.errs = append(qb.errs, err)
+		return qb
+	}
+
+	j := join{kind: kind, table: t}
+	errs := len(qb.errs)
+	qb.addConditions(&j.on, "AND", on)
+
+	// Empty conditions are dropped, which must not turn the join into a
+	// cross join
+	if len(j.on.conditions) == 0 && len(qb.errs) == errs {
+		qb.errs = append(qb.errs, fmt.Errorf("%w: %s %s needs an ON condition", ErrInvalidStatement, kind, table))
+		return qb
+	}
+
+	qb.selection.joins = append(qb.selection.joins, j)
+	return qb
+}
+
+// On compares two columns for equality, e.g. u.id = a.user_id.
+func On(left, right string) QueryCondition {
+	return OnComparison(left, OpEq, right)
+}
+
+// OnComparison compares two columns, e.g. o.created_at >= a.created_at.
+// It is mostly used in join conditions but works anywhere a condition does.
+func OnComparison(left string, op ComparisonOperator, right string) QueryCondition {
+	if err := validateColumnName(left); err != nil {
+		return QueryCondition{err: err}
+	}
+	if err := validateColumnName(right); err != nil {
+		return QueryCondition{err: err}
+	}
+
+	symbols, ok := comparisonOperators[op]
+	if !ok {
+		return QueryCondition{err: fmt.Errorf("%w: comparison operator %d", ErrUnsupportedValue, op)}
+	}
+
+	return QueryCondition{
+		condition:    "%s " + symbols[0] + " %s",
+		negCondition: "%s " + symbols[1] + " %s",
+		column:       left,
+		otherColumn:  right,
+	}
+}
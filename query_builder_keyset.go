@@ -0,0 +1,209 @@
+package querybuilder
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// After continues keyset pagination after the row with the given sort-key
+// values, one per SortBy field in the same order. Instead of skipping rows
+// with OFFSET, the query only returns rows past that position. Include a
+// unique column (e.g. id) as the last sort field so positions never tie.
+// Use Limit for the page size: a non-zero Offset would skip rows past the
+// cursor and is reported as ErrInvalidStatement. Comparisons with NULL are
+// never true, so a nil value is reported as ErrInvalidCursor.
+func (qb *QueryBuilder) After(values ...any) *QueryBuilder {
+	if err := checkCursorValues(values); err != nil {
+		qb.errs = append(qb.errs, err)
+		return qb
+	}
+
+	if len(values) > 0 {
+		qb.cursor = values
+	}
+	return qb
+}
+
+// AfterCursor is like After but takes an opaque cursor created by
+// EncodeCursor or NextCursor. An empty cursor is the first page; a
+// malformed one is reported as ErrInvalidCursor.
+func (qb *QueryBuilder) AfterCursor(cursor string) *QueryBuilder {
+	if cursor == "" {
+		return qb
+	}
+
+	values, err := DecodeCursor(cursor)
+	if err != nil {
+		qb.errs = append(qb.errs, err)
+		return qb
+	}
+	return qb.After(values...)
+}
+
+// NextCursor returns the cursor of the page after row, usually the last row
+// of the current page, keyed by column name. Sort fields are looked up by
+// their full name and then without a table prefix ("a.id" → "id").
+func (qb *QueryBuilder) NextCursor(row map[string]any) (string, error) {
+	if len(qb.sortFields) == 0 {
+		return "", fmt.Errorf("%w: keyset pagination needs SortBy", ErrInvalidStatement)
+	}
+
+	values := make([]any, len(qb.sortFields))
+	for i, field := range qb.sortFields {
+		value, ok := row[field.field]
+		if !ok {
+			_, column, _ := strings.Cut(field.field, ".")
+			value, ok = row[column]
+		}
+		if !ok {
+			return "", fmt.Errorf("%w: row has no value for sort field %s", ErrInvalidCursor, field.field)
+		}
+		if value == nil {
+			return "", fmt.Errorf("%w: sort field %s is NULL", ErrInvalidCursor, field.field)
+		}
+		values[i] = value
+	}
+	return EncodeCursor(values...)
+}
+
+// EncodeCursor encodes sort-key values as an opaque, URL safe cursor.
+// Values are stored as JSON, so times come back as RFC 3339 strings and
+// numbers as int64 or float64. Nil values are reported as ErrInvalidCursor.
+func EncodeCursor(values ...any) (string, error) {
+	if err := checkCursorValues(values); err != nil {
+		return "", err
+	}
+
+	data, err := json.Marshal(values)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// DecodeCursor decodes a cursor created by EncodeCursor.
+func DecodeCursor(cursor string) ([]any, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var values []any
+	if err := decoder.Decode(&values); err != nil || len(values) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidCursor, cursor)
+	}
+
+	for i, value := range values {
+		switch v := value.(type) {
+		case json.Number:
+			if n, err := v.Int64(); err == nil {
+				values[i] = n
+			} else if f, err := v.Float64(); err == nil {
+				values[i] = f
+			}
+		case nil, []any, map[string]any:
+			return nil, fmt.Errorf("%w: %s", ErrInvalidCursor, cursor)
+		}
+	}
+	return values, nil
+}
+
+// checkCursorValues reports nil cursor values, which would compare as NULL
+// and end the pagination at the first row with a NULL sort key.
+func checkCursorValues(values []any) error {
+	for i, value := range values {
+		if value == nil {
+			return fmt.Errorf("%w: cursor value %d is NULL", ErrInvalidCursor, i+1)
+		}
+	}
+	return nil
+}
+
+// renderKeysetWhere renders the WHERE clause with the keyset predicate added
+// to the conditions with AND. Conditions joined with OR are parenthesised
+// first so the predicate applies to all of them.
+func (qb *QueryBuilder) renderKeysetWhere() (string, error) {
+	var conditions string
+	if len(qb.conditions) > 0 {
+		conditions = qb.renderConditions(qb.whereClause)
+		if slices.Contains(qb.operators[1:], "OR") {
+			conditions = "(" + conditions + ")"
+		}
+		conditions += " AND "
+	}
+
+	predicate, err := qb.renderKeyset()
+	if err != nil {
+		return "", err
+	}
+	return " WHERE " + conditions + predicate, nil
+}
+
+// renderKeyset renders the predicate selecting the rows after the cursor.
+// Sort fields in one direction compare as a row value, (a, b) > ($1, $2),
+// where the dialect supports it; mixed directions expand to
+// (a > $1 OR (a = $2 AND b < $3)).
+func (qb *QueryBuilder) renderKeyset() (string, error) {
+	if len(qb.sortFields) == 0 {
+		return "", fmt.Errorf("%w: keyset pagination needs SortBy", ErrInvalidStatement)
+	}
+
+	if qb.offsetValue > 0 {
+		return "", fmt.Errorf("%w: keyset pagination cannot be combined with an offset", ErrInvalidStatement)
+	}
+
+	if len(qb.cursor) != len(qb.sortFields) {
+		return "", fmt.Errorf("%w: cursor has %d values for %d sort fields", ErrArgumentCount, len(qb.cursor), len(qb.sortFields))
+	}
+
+	columns := make([]string, len(qb.sortFields))
+	operators := make([]string, len(qb.sortFields))
+	sameDirection := true
+	for i, field := range qb.sortFields {
+		// NULLs and CASE buckets have no single position to continue after
+		if field.nulls != nullsDefault || field.priority != nil {
+			return "", fmt.Errorf("%w: keyset pagination does not support NULLS ordering or SortPriority (%s)", ErrInvalidStatement, field.field)
+		}
+
+		columns[i] = qb.ident(field.field)
+		operators[i] = ">"
+		if field.direction == SortDesc {
+			operators[i] = "<"
+		}
+		sameDirection = sameDirection && field.direction == qb.sortFields[0].direction
+	}
+
+	if len(columns) == 1 {
+		return columns[0] + " " + operators[0] + " " + qb.bindValue(qb.cursor[0]), nil
+	}
+
+	if sameDirection && qb.dialect.RowValues() {
+		placeholders := make([]string, len(qb.cursor))
+		for i, value := range qb.cursor {
+			placeholders[i] = qb.bindValue(value)
+		}
+		return "(" + strings.Join(columns, ", ") + ") " + operators[0] + " (" + strings.Join(placeholders, ", ") + ")", nil
+	}
+
+	terms := make([]string, len(columns))
+	for i := range columns {
+		parts := []string{}
+		for j := 0; j < i; j++ {
+			parts = append(parts, columns[j]+" = "+qb.bindValue(qb.cursor[j]))
+		}
+		parts = append(parts, columns[i]+" "+operators[i]+" "+qb.bindValue(qb.cursor[i]))
+
+		terms[i] = strings.Join(parts, " AND ")
+		if len(parts) > 1 {
+			terms[i] = "(" + terms[i] + ")"
+		}
+	}
+	return "(" + strings.Join(terms, " OR ") + ")", nil
+}
@@ -2,35 +2,25 @@ package querybuilder
 
 import (
 	"fmt"
+	"math/big"
+	"strings"
 	"time"
 )
 
 func ByIntColumn(column string, values []int) QueryCondition {
-	if err := validateColumnName(column); err != nil {
-		panic(err)
-	}
-
-	if len(values) == 0 {
-		return QueryCondition{}
-	}
+	return ByColumn(column, values)
+}
 
-	if len(values) == 1 {
-		return QueryCondition{
-			condition:   fmt.Sprintf("%s = $1", column),
-			value:       values[0],
-			placeholder: "%v",
-		}
-	}
-	return QueryCondition{
-		condition:   fmt.Sprintf("%s IN $1", column),
-		value:       values,
-		placeholder: "%v",
-	}
+// ByColumn matches a column of any type against one value (=) or several (IN).
+// Each value is bound as-is, so any type the driver accepts can be used
+// (int64, uuid.UUID, custom ID types, ...).
+func ByColumn[T any](column string, values []T) QueryCondition {
+	return byValues(column, toAnySlice(values))
 }
 
 func ByStringColumn(column string, values []string, options ...any) QueryCondition {
-	if err := validateColumnName(column); err != nil {
-		panic(err)
+	if err := validateColumnRef(column); err != nil {
+		return QueryCondition{err: err}
 	}
 
 	if len(values) == 0 {
@@ -40,6 +30,7 @@ func ByStringColumn(column string, values []string, options ...any) QueryConditi
 	// Parse options - can be StringOpts struct, StringMatchType, or both StringMatchType and StringSensitivityType
 	var mt StringMatchType = StringExact
 	var sensitivity StringSensitivityType = Sensitive
+	var patternType StringPatternType = PatternEscaped
 
 	for _, opt := range options {
 		switch v := opt.(type) {
@@ -50,68 +41,83 @@ func ByStringColumn(column string, values []string, options ...any) QueryConditi
 			if v.Sensitivity != 0 {
 				sensitivity = v.Sensitivity
 			}
+			if v.Pattern != 0 {
+				patternType = v.Pattern
+			}
 		case StringMatchType:
 			mt = v
 		case StringSensitivityType:
 			sensitivity = v
+		case StringPatternType:
+			patternType = v
+		default:
+			return QueryCondition{err: fmt.Errorf("%w: string option of type %T", ErrUnsupportedValue, opt)}
 		}
 	}
 
 	if len(values) > 1 {
-		return QueryCondition{
-			condition:   fmt.Sprintf("%s IN $1", column),
-			value:       values,
-			placeholder: "%s",
-		}
+		return byValues(column, toAnySlice(values))
 	}
 
 	value := values[0]
 	caseSensitive := sensitivity == Sensitive
 
-	var condition string
-	var actualValue string
+	// LIKE patterns are concatenated by the dialect when the condition is bound
+	bound := "$1"
+	if !caseSensitive {
+		bound = "LOWER($1)"
+	}
+
+	var condition, negCondition string
+	var pattern []string
 
 	switch mt {
 	case StringExact:
 		if caseSensitive {
-			condition = fmt.Sprintf("%s = $1", column)
+			condition = "%s = $1"
+			negCondition = "%s <> $1"
 		} else {
-			condition = fmt.Sprintf("LOWER(%s) = LOWER($1)", column)
+			condition = "LOWER(%s) = LOWER($1)"
+			negCondition = "LOWER(%s) <> LOWER($1)"
 		}
-		actualValue = value
 	case StringContains:
-		if caseSensitive {
-			condition = fmt.Sprintf("%s LIKE '%%' || $1 || '%%'", column)
-		} else {
-			condition = fmt.Sprintf("LOWER(%s) LIKE '%%' || LOWER($1) || '%%'", column)
-		}
-		actualValue = value
+		pattern = []string{"'%'", bound, "'%'"}
 	case StringStartsWith:
-		if caseSensitive {
-			condition = fmt.Sprintf("%s LIKE $1 || '%%'", column)
-		} else {
-			condition = fmt.Sprintf("LOWER(%s) LIKE LOWER($1) || '%%'", column)
-		}
-		actualValue = value
+		pattern = []string{bound, "'%'"}
 	case StringEndsWith:
+		pattern = []string{"'%'", bound}
+	}
+
+	if pattern != nil {
 		if caseSensitive {
-			condition = fmt.Sprintf("%s LIKE '%%' || $1", column)
+			condition = "%s LIKE %s"
+			negCondition = "%s NOT LIKE %s"
 		} else {
-			condition = fmt.Sprintf("LOWER(%s) LIKE '%%' || LOWER($1)", column)
+			condition = "LOWER(%s) LIKE %s"
+			negCondition = "LOWER(%s) NOT LIKE %s"
+		}
+
+		// Wildcards in the value match literally unless a raw pattern is requested
+		if patternType == PatternEscaped {
+			value = likeEscaper.Replace(value)
+			condition += " ESCAPE '" + likeEscapeChar + "'"
+			negCondition += " ESCAPE '" + likeEscapeChar + "'"
 		}
-		actualValue = value
 	}
 
 	return QueryCondition{
-		condition:   condition,
-		value:       actualValue,
-		placeholder: "%s",
+		condition:    condition,
+		negCondition: negCondition,
+		column:       column,
+		value:        value,
+		pattern:      pattern,
+		placeholder:  "%s",
 	}
 }
 
 func ByDateColumn(column string, dates Dates) QueryCondition {
-	if err := validateColumnName(column); err != nil {
-		panic(err)
+	if err := validateColumnRef(column); err != nil {
+		return QueryCondition{err: err}
 	}
 
 	// Check if all dates are zero (empty)
@@ -125,14 +131,17 @@ func ByDateColumn(column string, dates Dates) QueryCondition {
 
 	// Priority: On field takes precedence
 	if !dates.On.IsZero() {
-		// Exact date match using DATE function (SQLite compatible)
-		condition = fmt.Sprintf("DATE(%s) = DATE($1)", column)
+		// Exact date match; the dialect renders the date of both sides
+		condition = "%s = %s"
 		value = dates.On.Format(time.RFC3339)
 		placeholder = "%s"
 		return QueryCondition{
-			condition:   condition,
-			value:       value,
-			placeholder: placeholder,
+			condition:    condition,
+			negCondition: "%s <> %s",
+			column:       column,
+			value:        value,
+			placeholder:  placeholder,
+			dateOnly:     true,
 		}
 	}
 
@@ -142,24 +151,284 @@ func ByDateColumn(column string, dates Dates) QueryCondition {
 
 	if hasAfter && hasBefore {
 		// Both dates set: BETWEEN query with two placeholders (inclusive)
-		condition = fmt.Sprintf("%s >= $1 AND %s <= $2", column, column)
-		value = []string{dates.After.Format(time.RFC3339), dates.Before.Format(time.RFC3339)}
+		condition = "%[1]s >= $1 AND %[1]s <= $2"
+		value = boundValues{dates.After.Format(time.RFC3339), dates.Before.Format(time.RFC3339)}
 		placeholder = "%s"
 	} else if hasAfter && !hasBefore {
 		// Only after set: AFTER query (exclusive)
-		condition = fmt.Sprintf("%s > $1", column)
+		condition = "%s > $1"
 		value = dates.After.Format(time.RFC3339)
 		placeholder = "%s"
 	} else if !hasAfter && hasBefore {
 		// Only before set: BEFORE query (exclusive)
-		condition = fmt.Sprintf("%s < $1", column)
+		condition = "%s < $1"
 		value = dates.Before.Format(time.RFC3339)
 		placeholder = "%s"
 	}
 
 	return QueryCondition{
 		condition:   condition,
+		column:      column,
 		value:       value,
 		placeholder: placeholder,
 	}
 }
+
+// likeEscapeChar escapes LIKE wildcards. A backslash would need doubling in
+// MySQL string literals, so a character with no special meaning is used.
+const likeEscapeChar = "!"
+
+// likeEscaper also escapes [, which opens a character class in SQL Server
+// LIKE patterns; the other dialects match an escaped [ literally.
+var likeEscaper = strings.NewReplacer(
+	likeEscapeChar, likeEscapeChar+likeEscapeChar,
+	"%", likeEscapeChar+"%",
+	"_", likeEscapeChar+"_",
+	"[", likeEscapeChar+"[",
+)
+
+// comparisonOperators maps each operator to its SQL symbol and its negation.
+var comparisonOperators = map[ComparisonOperator][2]string{
+	OpEq:    {"=", "<>"},
+	OpNotEq: {"<>", "="},
+	OpGt:    {">", "<="},
+	OpGte:   {">=", "<"},
+	OpLt:    {"<", ">="},
+	OpLte:   {"<=", ">"},
+}
+
+// ByIntComparison compares an integer column against a value, e.g. age >= $1.
+func ByIntComparison(column string, op ComparisonOperator, value int) QueryCondition {
+	return byComparison(column, op, value)
+}
+
+// ByIntRange matches an integer column against a Range.
+func ByIntRange(column string, r Range[int]) QueryCondition {
+	return byRange(column, r)
+}
+
+func byComparison(column string, op ComparisonOperator, value any) QueryCondition {
+	if err := validateColumnRef(column); err != nil {
+		return QueryCondition{err: err}
+	}
+
+	symbols, ok := comparisonOperators[op]
+	if !ok {
+		return QueryCondition{err: fmt.Errorf("%w: comparison operator %d", ErrUnsupportedValue, op)}
+	}
+
+	return QueryCondition{
+		condition:    "%s " + symbols[0] + " $1",
+		negCondition: "%s " + symbols[1] + " $1",
+		column:       column,
+		value:        value,
+		placeholder:  "%v",
+	}
+}
+
+func byRange[T any](column string, r Range[T]) QueryCondition {
+	if err := validateColumnRef(column); err != nil {
+		return QueryCondition{err: err}
+	}
+
+	if r.Min == nil && r.Max == nil {
+		return QueryCondition{}
+	}
+
+	minOp, maxOp := OpGte, OpLte
+	if r.MinExclusive {
+		minOp = OpGt
+	}
+	if r.MaxExclusive {
+		maxOp = OpLt
+	}
+
+	if r.Min != nil && r.Max == nil {
+		return byComparison(column, minOp, *r.Min)
+	}
+	if r.Min == nil && r.Max != nil {
+		return byComparison(column, maxOp, *r.Max)
+	}
+
+	// Both bounds set: two placeholders, like a date range
+	return QueryCondition{
+		condition:   "%[1]s " + comparisonOperators[minOp][0] + " $1 AND %[1]s " + comparisonOperators[maxOp][0] + " $2",
+		column:      column,
+		value:       boundValues{*r.Min, *r.Max},
+		placeholder: "%v",
+	}
+}
+
+// ByFloatColumn matches a float column against one value (=) or several (IN).
+func ByFloatColumn(column string, values []float64) QueryCondition {
+	return byValues(column, toAnySlice(values))
+}
+
+// ByFloatComparison compares a float column against a value, e.g. rating > $1.
+func ByFloatComparison(column string, op ComparisonOperator, value float64) QueryCondition {
+	return byComparison(column, op, value)
+}
+
+// ByFloatRange matches a float column against a Range.
+func ByFloatRange(column string, r Range[float64]) QueryCondition {
+	return byRange(column, r)
+}
+
+// ByDecimalColumn matches a decimal column against one value (=) or several (IN).
+// Values are given as decimal strings such as "19.99" or as *big.Rat, and are
+// bound as decimal strings so no precision is lost to float64.
+func ByDecimalColumn[D string | *big.Rat](column string, values []D) QueryCondition {
+	decimals, err := decimalStrings(values...)
+	if err != nil {
+		return QueryCondition{err: err}
+	}
+	return byValues(column, toAnySlice(decimals))
+}
+
+// ByDecimalComparison compares a decimal column against a value, e.g. price <= $1.
+func ByDecimalComparison[D string | *big.Rat](column string, op ComparisonOperator, value D) QueryCondition {
+	decimals, err := decimalStrings(value)
+	if err != nil {
+		return QueryCondition{err: err}
+	}
+	return byComparison(column, op, decimals[0])
+}
+
+// ByDecimalRange matches a decimal column against a Range.
+func ByDecimalRange[D string | *big.Rat](column string, r Range[D]) QueryCondition {
+	var bounds Range[string]
+	bounds.MinExclusive = r.MinExclusive
+	bounds.MaxExclusive = r.MaxExclusive
+
+	if r.Min != nil {
+		decimals, err := decimalStrings(*r.Min)
+		if err != nil {
+			return QueryCondition{err: err}
+		}
+		bounds.Min = &decimals[0]
+	}
+	if r.Max != nil {
+		decimals, err := decimalStrings(*r.Max)
+		if err != nil {
+			return QueryCondition{err: err}
+		}
+		bounds.Max = &decimals[0]
+	}
+	return byRange(column, bounds)
+}
+
+func byValues(column string, values []any) QueryCondition {
+	if err := validateColumnRef(column); err != nil {
+		return QueryCondition{err: err}
+	}
+
+	if len(values) == 0 {
+		return QueryCondition{}
+	}
+
+	if len(values) == 1 {
+		return QueryCondition{
+			condition:    "%s = $1",
+			negCondition: "%s <> $1",
+			column:       column,
+			value:        values[0],
+			placeholder:  "%v",
+		}
+	}
+	return QueryCondition{
+		condition:    "%s IN $1",
+		negCondition: "%s NOT IN $1",
+		column:       column,
+		value:        boundValues(values),
+		placeholder:  "%v",
+	}
+}
+
+func toAnySlice[T any](values []T) []any {
+	out := make([]any, len(values))
+	for i, v := range values {
+		out[i] = v
+	}
+	return out
+}
+
+// decimalStrings converts decimal strings and *big.Rat values to validated
+// decimal strings. A *big.Rat must have a finite decimal expansion.
+func decimalStrings[D string | *big.Rat](values ...D) ([]string, error) {
+	out := make([]string, len(values))
+	for i, value := range values {
+		switch v := any(value).(type) {
+		case string:
+			if !decimalRegex.MatchString(v) {
+				return nil, fmt.Errorf("%w: invalid decimal %q", ErrUnsupportedValue, v)
+			}
+			out[i] = v
+		case *big.Rat:
+			if v == nil {
+				return nil, fmt.Errorf("%w: nil decimal", ErrUnsupportedValue)
+			}
+			digits, ok := decimalDigits(v.Denom())
+			if !ok {
+				return nil, fmt.Errorf("%w: %s has no finite decimal representation", ErrUnsupportedValue, v.RatString())
+			}
+			out[i] = v.FloatString(digits)
+		}
+	}
+	return out, nil
+}
+
+// decimalDigits returns the number of fractional digits needed to write
+// 1/denom exactly, which is possible only when denom = 2^a * 5^b.
+func decimalDigits(denom *big.Int) (int, bool) {
+	d := new(big.Int).Set(denom)
+	two, five := big.NewInt(2), big.NewInt(5)
+	var twos, fives int
+	mod := new(big.Int)
+	for d.Cmp(big.NewInt(1)) != 0 {
+		switch {
+		case mod.Mod(d, two).Sign() == 0:
+			d.Quo(d, two)
+			twos++
+		case mod.Mod(d, five).Sign() == 0:
+			d.Quo(d, five)
+			fives++
+		default:
+			return 0, false
+		}
+	}
+	return max(twos, fives), true
+}
+
+// ByBoolColumn matches a boolean column against a literal, e.g. is_active = TRUE.
+// The value is rendered by the dialect and binds no placeholder.
+func ByBoolColumn(column string, value bool) QueryCondition {
+	if err := validateColumnRef(column); err != nil {
+		return QueryCondition{err: err}
+	}
+
+	return QueryCondition{
+		condition:    "%s = $1",
+		negCondition: "%s <> $1",
+		column:       column,
+		value:        value,
+		inline:       true,
+	}
+}
+
+// IsNull matches rows where column IS NULL.
+func IsNull(column string) QueryCondition {
+	if err := validateColumnRef(column); err != nil {
+		return QueryCondition{err: err}
+	}
+
+	return QueryCondition{
+		condition:    "%s IS NULL",
+		negCondition: "%s IS NOT NULL",
+		column:       column,
+	}
+}
+
+// IsNotNull matches rows where column IS NOT NULL.
+func IsNotNull(column string) QueryCondition {
+	return Not(IsNull(column))
+}
@@ -0,0 +1,159 @@
+package querybuilder
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	// defaultPageSize is the limit applied when only an offset is set and the
+	// page size used by Paginate when none is given, unless WithDefaultLimit
+	// is used.
+	defaultPageSize = 10
+	// defaultMaxPageSize is the largest page size Paginate allows unless
+	// configured with WithMaxPageSize.
+	defaultMaxPageSize = 100
+)
+
+// PageInfo describes a page of results, e.g. for a list endpoint's response.
+type PageInfo struct {
+	Page       int  `json:"page"`
+	PageSize   int  `json:"page_size"`
+	Total      int  `json:"total"`
+	TotalPages int  `json:"total_pages"`
+	HasNext    bool `json:"has_next"`
+	HasPrev    bool `json:"has_prev"`
+}
+
+// limitOptions holds the limit settings of a QueryBuilder.
+type limitOptions struct {
+	defaultLimit int
+	maxLimit     int
+	policy       LimitPolicy
+	maxPageSize  int
+}
+
+// WithMaxPageSize sets the largest page size Paginate allows. Defaults to 100.
+func WithMaxPageSize(n int) Option {
+	return func(st *statement) {
+		st.limits.maxPageSize = n
+	}
+}
+
+// WithDefaultLimit sets the limit used when Limit is not called, with or
+// without an offset. Without it, a limit of 10 is only applied with an offset.
+func WithDefaultLimit(n int) Option {
+	return func(st *statement) {
+		st.limits.defaultLimit = n
+	}
+}
+
+// WithMaxLimit sets the largest limit a query may have. A larger limit is
+// clamped to n, or reported by Build as ErrInvalidLimit with LimitError. A
+// query without a limit or default limit gets n, so it is never unbounded.
+func WithMaxLimit(n int, policy ...LimitPolicy) Option {
+	return func(st *statement) {
+		st.limits.maxLimit = n
+		if len(policy) > 0 {
+			st.limits.policy = policy[0]
+		}
+	}
+}
+
+// Paginate sets the limit and offset for a 1-based page number, as given by
+// page and per_page query parameters. A page below 1 is the first page, a
+// size below 1 is the default limit (10 unless set with WithDefaultLimit)
+// and a size above the maximum page size or WithMaxLimit is reduced to it,
+// whatever the LimitPolicy. A page whose
+// offset would overflow is reported as ErrInvalidLimit.
+func (qb *QueryBuilder) Paginate(page, size int) *QueryBuilder {
+	page = max(page, 1)
+	if size < 1 {
+		size = defaultPageSize
+		if qb.limits.defaultLimit > 0 {
+			size = qb.limits.defaultLimit
+		}
+	}
+	size = min(size, qb.pageSizeLimit())
+
+	if page-1 > math.MaxInt/size {
+		qb.errs = append(qb.errs, fmt.Errorf("%w: page %d is out of range", ErrInvalidLimit, page))
+		return qb
+	}
+
+	qb.limitValue = size
+	qb.offsetValue = (page - 1) * size
+	return qb
+}
+
+// PageInfo returns the page metadata for the builder's limit and offset
+// given the total number of rows, e.g. from CountQuery.
+func (qb *QueryBuilder) PageInfo(total int) PageInfo {
+	// A limit over the maximum with LimitError fails to build; report the
+	// page it would have had
+	size, err := qb.effectiveLimit()
+	if err != nil {
+		size = qb.limitValue
+	}
+
+	// Without a limit every row is on the first page; a zero limit returns
+	// no rows, so there are no pages
+	if size < 0 {
+		return NewPageInfo(1, max(total, 1), total)
+	}
+	if size == 0 {
+		return NewPageInfo(1, 0, total)
+	}
+	return NewPageInfo(max(qb.offsetValue, 0)/size+1, size, total)
+}
+
+// NewPageInfo computes the page metadata of a 1-based page.
+func NewPageInfo(page, size, total int) PageInfo {
+	info := PageInfo{Page: page, PageSize: size, Total: total}
+	if size > 0 {
+		info.TotalPages = (total + size - 1) / size
+	}
+	info.HasNext = page < info.TotalPages
+	info.HasPrev = page > 1
+	return info
+}
+
+// pageSizeLimit returns the largest page size Paginate allows. The maximum
+// limit applies as well, so the offset is computed from the rendered limit.
+func (st *statement) pageSizeLimit() int {
+	limit := defaultMaxPageSize
+	if st.limits.maxPageSize > 0 {
+		limit = st.limits.maxPageSize
+	}
+	if st.limits.maxLimit > 0 {
+		limit = min(limit, st.limits.maxLimit)
+	}
+	return limit
+}
+
+// effectiveLimit returns the limit to render, or -1 for none, applying the
+// default and maximum limits.
+func (qb *QueryBuilder) effectiveLimit() (int, error) {
+	limits := qb.limits
+
+	limit := qb.limitValue
+	if limit < 0 {
+		switch {
+		case limits.defaultLimit > 0:
+			limit = limits.defaultLimit
+		case qb.offsetValue >= 0:
+			limit = defaultPageSize
+		case limits.maxLimit > 0:
+			limit = limits.maxLimit
+		}
+	}
+
+	if limits.maxLimit > 0 && limit > limits.maxLimit {
+		// Only an explicit limit is an error; defaults are always clamped
+		if limits.policy == LimitError && qb.limitValue >= 0 {
+			return 0, fmt.Errorf("%w: limit %d exceeds the maximum of %d", ErrInvalidLimit, limit, limits.maxLimit)
+		}
+		limit = limits.maxLimit
+	}
+	return limit, nil
+}
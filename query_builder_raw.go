@@ -0,0 +1,115 @@
+package querybuilder
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Raw builds a condition from a SQL fragment with ? markers and one argument
+// per marker, e.g. Raw("score + bonus > ? AND region = ?", 10, "eu").
+// Markers are renumbered into the builder's placeholder sequence. A slice
+// argument expands to a parenthesised list, e.g. Raw("id IN ?", ids); an
+// empty slice would render invalid SQL and is reported as ErrUnsupportedValue.
+// Use ?? for a literal question mark; markers inside quotes are left alone.
+//
+// The fragment is not validated: never build it from user input.
+func Raw(sql string, args ...any) QueryCondition {
+	sql = strings.TrimSpace(sql)
+	if sql == "" {
+		return QueryCondition{}
+	}
+
+	parts := splitRawMarkers(sql)
+	if len(parts)-1 != len(args) {
+		return QueryCondition{err: fmt.Errorf("%w: raw condition %q has %d markers but %d arguments", ErrArgumentCount, sql, len(parts)-1, len(args))}
+	}
+
+	for i, arg := range args {
+		if items, ok := expandArg(arg); ok && len(items) == 0 {
+			return QueryCondition{err: fmt.Errorf("%w: raw condition %q has an empty list for argument %d", ErrUnsupportedValue, sql, i+1)}
+		}
+	}
+
+	return QueryCondition{
+		condition: sql,
+		rawParts:  parts,
+		value:     args,
+	}
+}
+
+// splitRawMarkers splits sql around its ? markers, skipping quoted text and
+// unescaping ?? to ?.
+func splitRawMarkers(sql string) []string {
+	var parts []string
+	var current strings.Builder
+	var quote rune
+
+	runes := []rune(sql)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+			current.WriteRune(r)
+		case r == '\'' || r == '"' || r == '`':
+			quote = r
+			current.WriteRune(r)
+		case r == '?' && i+1 < len(runes) && runes[i+1] == '?':
+			current.WriteRune('?')
+			i++
+		case r == '?':
+			parts = append(parts, current.String())
+			current.Reset()
+		default:
+			current.WriteRune(r)
+		}
+	}
+	return append(parts, current.String())
+}
+
+// bindRaw renders a raw condition, placing one placeholder per argument and
+// expanding slice arguments into lists. The fragment is parenthesised so an
+// OR inside it cannot change the meaning of the surrounding conditions.
+func (st *statement) bindRaw(cond QueryCondition) string {
+	args := cond.value.([]any)
+
+	var sb strings.Builder
+	sb.WriteString("(" + cond.rawParts[0])
+	for i, arg := range args {
+		if items, ok := expandArg(arg); ok {
+			placeholders := make([]string, len(items))
+			for j, item := range items {
+				placeholders[j] = st.bindValue(item)
+			}
+			sb.WriteString("(" + strings.Join(placeholders, ", ") + ")")
+		} else {
+			sb.WriteString(st.bindValue(arg))
+		}
+		sb.WriteString(cond.rawParts[i+1])
+	}
+	sb.WriteString(")")
+	return sb.String()
+}
+
+// expandArg returns the items of a slice argument. Byte slices and types
+// implementing driver.Valuer are bound as a single value.
+func expandArg(arg any) ([]any, bool) {
+	if _, ok := arg.(driver.Valuer); ok {
+		return nil, false
+	}
+
+	v := reflect.ValueOf(arg)
+	if v.Kind() != reflect.Slice || v.Type().Elem().Kind() == reflect.Uint8 {
+		return nil, false
+	}
+
+	items := make([]any, v.Len())
+	for i := range items {
+		items[i] = v.Index(i).Interface()
+	}
+	return items, true
+}
@@ -0,0 +1,171 @@
+package querybuilder
+
+import (
+	"fmt"
+	"strings"
+)
+
+// selectClause holds the columns and table of a structured SELECT built
+// with Select and From instead of a base query string.
+type selectClause struct {
+	columns []selectColumn
+	table   selectColumn
+	joins   []join
+}
+
+// selectColumn is a column, table or * with an optional alias.
+type selectColumn struct {
+	name  string
+	alias string
+}
+
+// Select starts a structured query selecting the given columns. Columns may
+// carry an alias ("users.name AS author") and default to *. Use
+// NewQueryBuilder("", opts...).Select(...) to set options.
+func Select(columns ...string) *QueryBuilder {
+	return NewQueryBuilder("").Select(columns...)
+}
+
+// Select sets the columns of a structured query. It replaces the base query
+// once From is called.
+func (qb *QueryBuilder) Select(columns ...string) *QueryBuilder {
+	for _, column := range columns {
+		col, err := parseSelectColumn(column)
+		if err != nil {
+			qb.errs = append(qb.errs, err)
+			continue
+		}
+		qb.selection.columns = append(qb.selection.columns, col)
+	}
+	return qb
+}
+
+// SelectFields projects the columns requested by an API "fields" parameter.
+// allowed maps each field name to its column; a column with a different name
+// is aliased back to the field. Unknown fields are reported as
+// ErrInvalidColumnName, blank and duplicate fields are ignored and no fields
+// leaves the selection unchanged.
+func (qb *QueryBuilder) SelectFields(fields []string, allowed map[string]string) *QueryBuilder {
+	seen := map[string]bool{}
+	for _, field := range fields {
+		field = strings.TrimSpace(field)
+		if field == "" || seen[field] {
+			continue
+		}
+		seen[field] = true
+
+		column, ok := allowed[field]
+		if !ok {
+			qb.errs = append(qb.errs, fmt.Errorf("%w: unknown field %q", ErrInvalidColumnName, field))
+			continue
+		}
+
+		col := selectColumn{name: column}
+		if column != field {
+			col.alias = field
+		}
+
+		if err := col.validate(); err != nil {
+			qb.errs = append(qb.errs, err)
+			continue
+		}
+		qb.selection.columns = append(qb.selection.columns, col)
+	}
+	return qb
+}
+
+// From sets the table of a structured query, optionally aliased
+// ("accounts AS a").
+func (qb *QueryBuilder) From(table string) *QueryBuilder {
+	t, err := parseTable(table)
+	if err != nil {
+		qb.errs = append(qb.errs, err)
+		return qb
+	}
+	qb.selection.table = t
+	return qb
+}
+
+// renderSelect renders the SELECT ... FROM part of a structured query,
+// including its joins.
+func (st *statement) renderSelect(s selectClause) string {
+	columns := []string{"*"}
+	if len(s.columns) > 0 {
+		columns = make([]string, len(s.columns))
+		for i, col := range s.columns {
+			columns[i] = st.aliased(col)
+		}
+	}
+	query := "SELECT " + strings.Join(columns, ", ") + " FROM " + st.aliased(s.table)
+	for _, j := range s.joins {
+		query += " " + j.kind + " " + st.aliased(j.table) + " ON " + st.renderConditions(j.on)
+	}
+	return query
+}
+
+func (st *statement) aliased(col selectColumn) string {
+	name := st.ident(col.name)
+	if col.alias == "" {
+		return name
+	}
+	return name + " AS " + st.ident(col.alias)
+}
+
+// parseSelectColumn splits "name AS alias" and validates both parts.
+func parseSelectColumn(column string) (selectColumn, error) {
+	col, err := splitAlias(column)
+	if err != nil {
+		return col, err
+	}
+	return col, col.validate()
+}
+
+// parseTable splits "table AS alias" and validates both parts. Unlike a
+// column, a table cannot be * or an expression.
+func parseTable(table string) (selectColumn, error) {
+	t, err := splitAlias(table)
+	if err != nil {
+		return t, err
+	}
+
+	if err := validateColumnName(t.name); err != nil {
+		return t, err
+	}
+	if t.alias != "" {
+		return t, validateAlias(t.alias)
+	}
+	return t, nil
+}
+
+// splitAlias splits "name AS alias" without validating either part.
+func splitAlias(column string) (selectColumn, error) {
+	fields := strings.Fields(column)
+
+	var col selectColumn
+	switch {
+	case len(fields) == 1:
+		col.name = fields[0]
+	case len(fields) == 3 && strings.EqualFold(fields[1], "AS"):
+		col.name, col.alias = fields[0], fields[2]
+	default:
+		return col, fmt.Errorf("%w: %s (expected \"column\" or \"column AS alias\")", ErrInvalidColumnName, column)
+	}
+	return col, nil
+}
+
+func (col selectColumn) validate() error {
+	name := col.name
+	if name == "*" {
+		return nil
+	}
+	// table.* selects every column of one table
+	name = strings.TrimSuffix(name, ".*")
+
+	if err := validateColumnRef(name); err != nil {
+		return err
+	}
+	if col.alias != "" {
+		return validateAlias(col.alias)
+	}
+	return nil
+}
@@ -1,8 +1,17 @@
 package querybuilder
 
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+)
+
 func Sort(field string, direction ...SortDirection) SortField {
-	if err := validateColumnName(field); err != nil {
-		panic(err)
+	if err := validateColumnRef(field); err != nil {
+		return SortField{err: err}
 	}
 
 	dir := SortAsc
@@ -15,7 +24,169 @@ func Sort(field string, direction ...SortDirection) SortField {
 	}
 }
 
+// SortPriority sorts rows by the position of the column's value in order,
+// e.g. status buckets: CASE status WHEN $1 THEN 0 WHEN $2 THEN 1 ELSE 2 END.
+// Values not in order sort after the listed ones. The values are bound like
+// condition values.
+func SortPriority[T any](column string, order []T, direction ...SortDirection) SortField {
+	field := Sort(column, direction...)
+	if field.err != nil {
+		return field
+	}
+
+	if len(order) == 0 {
+		return SortField{err: fmt.Errorf("%w: SortPriority on %s needs at least one value", ErrUnsupportedValue, column)}
+	}
+
+	field.priority = toAnySlice(order)
+	return field
+}
+
+// Lower returns the LOWER(column) expression for case-insensitive sorting,
+// e.g. Sort(Lower("name")).
+func Lower(column string) string {
+	return sqlFunction("LOWER", column)
+}
+
+// NullsFirst sorts NULL values before all other values.
+func (f SortField) NullsFirst() SortField {
+	f.nulls = nullsFirst
+	return f
+}
+
+// NullsLast sorts NULL values after all other values.
+func (f SortField) NullsLast() SortField {
+	f.nulls = nullsLast
+	return f
+}
+
 func (qb *QueryBuilder) SortBy(fields ...SortField) *QueryBuilder {
-	qb.sortFields = append(qb.sortFields, fields...)
+	for _, field := range fields {
+		if field.err != nil {
+			qb.errs = append(qb.errs, field.err)
+			continue
+		}
+		qb.sortFields = append(qb.sortFields, field)
+	}
 	return qb
 }
+
+// renderSort renders a sort field for ORDER BY. NULL ordering is rendered
+// natively where the dialect supports it and otherwise emulated with a
+// leading CASE WHEN ... IS NULL sort key.
+func (st *statement) renderSort(field SortField) string {
+	expr := st.renderSortExpr(field)
+
+	direction := ""
+	if field.direction == SortDesc {
+		direction = " DESC"
+	}
+
+	if field.nulls == nullsDefault {
+		return expr + direction
+	}
+
+	first := field.nulls == nullsFirst
+	if clause := st.dialect.NullsOrder(first); clause != "" {
+		return expr + direction + clause
+	}
+
+	nullKey := "CASE WHEN " + expr + " IS NULL THEN 1 ELSE 0 END"
+	if first {
+		nullKey = "CASE WHEN " + expr + " IS NULL THEN 0 ELSE 1 END"
+	}
+	// The expression is rendered again so its values are bound in order
+	return nullKey + ", " + st.renderSortExpr(field) + direction
+}
+
+// renderSortExpr renders the expression a field sorts by.
+func (st *statement) renderSortExpr(field SortField) string {
+	column := st.ident(field.field)
+	if field.priority == nil {
+		return column
+	}
+
+	whens := make([]string, len(field.priority))
+	for i, value := range field.priority {
+		whens[i] = "WHEN " + st.bindValue(value) + " THEN " + strconv.Itoa(i)
+	}
+	return "CASE " + column + " " + strings.Join(whens, " ") + " ELSE " + strconv.Itoa(len(field.priority)) + " END"
+}
+
+// ParseSort parses an API sort specification such as "-created_at,name" or
+// "created_at:desc,name:asc". allowed maps each public field name to its
+// column. A leading "-" or a ":desc" suffix sorts descending; repeated
+// fields keep their first position. Unknown fields and malformed entries are
+// reported as ErrInvalidSort, all of them at once. An empty spec returns no
+// sort fields.
+func ParseSort(spec string, allowed map[string]string) ([]SortField, error) {
+	fields := []SortField{}
+	seen := map[string]bool{}
+	var errs []error
+
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		name, direction, err := parseSortEntry(entry)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		column, ok := allowed[name]
+		if !ok {
+			errs = append(errs, fmt.Errorf("%w: unknown field %q (allowed: %s)", ErrInvalidSort, name, strings.Join(slices.Sorted(maps.Keys(allowed)), ", ")))
+			continue
+		}
+
+		if seen[column] {
+			continue
+		}
+		seen[column] = true
+
+		field := Sort(column, direction)
+		if field.err != nil {
+			errs = append(errs, fmt.Errorf("%w: field %q: %w", ErrInvalidSort, name, field.err))
+			continue
+		}
+		fields = append(fields, field)
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return fields, nil
+}
+
+// parseSortEntry splits one entry of a sort specification into its field
+// name and direction.
+func parseSortEntry(entry string) (string, SortDirection, error) {
+	name, suffix, hasSuffix := strings.Cut(entry, ":")
+	descending := strings.HasPrefix(name, "-")
+	name = strings.TrimPrefix(name, "-")
+
+	if hasSuffix {
+		switch strings.ToLower(suffix) {
+		case "asc":
+			if descending {
+				return "", SortAsc, fmt.Errorf("%w: %q has both \"-\" and \":asc\"", ErrInvalidSort, entry)
+			}
+		case "desc":
+			descending = true
+		default:
+			return "", SortAsc, fmt.Errorf("%w: %q has unknown direction %q (expected asc or desc)", ErrInvalidSort, entry, suffix)
+		}
+	}
+
+	if name == "" {
+		return "", SortAsc, fmt.Errorf("%w: %q has no field name", ErrInvalidSort, entry)
+	}
+
+	if descending {
+		return name, SortDesc, nil
+	}
+	return name, SortAsc, nil
+}
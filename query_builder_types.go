@@ -5,40 +5,67 @@ import "time"
 type StringMatchType int
 
 const (
-    StringExact StringMatchType = iota
-    StringContains
-    StringStartsWith
-    StringEndsWith
+	StringExact StringMatchType = iota
+	StringContains
+	StringStartsWith
+	StringEndsWith
 )
 
 type StringSensitivityType int
 
 const (
-    Sensitive StringSensitivityType = iota
-    NonSensitive
+	Sensitive StringSensitivityType = iota
+	NonSensitive
+)
+
+// StringPatternType controls how LIKE values are treated.
+type StringPatternType int
+
+const (
+	PatternEscaped StringPatternType = iota // %, _ and ! in the value match literally (default)
+	PatternRaw                              // the value is used as a raw LIKE pattern
 )
 
 type DateRangeType int
 
 const (
-    DateExact DateRangeType = iota
-    DateAfter
-    DateBefore
-    DateBetween
+	DateExact DateRangeType = iota
+	DateAfter
+	DateBefore
+	DateBetween
 )
 
 type SortDirection int
 
 const (
-    SortAsc SortDirection = iota
-    SortDesc
+	SortAsc SortDirection = iota
+	SortDesc
+)
+
+// nullsOrder is the position of NULL values set with NullsFirst or NullsLast.
+type nullsOrder int
+
+const (
+	nullsDefault nullsOrder = iota
+	nullsFirst
+	nullsLast
+)
+
+// LimitPolicy controls what happens to a limit above the maximum set with
+// WithMaxLimit.
+type LimitPolicy int
+
+const (
+	LimitClamp LimitPolicy = iota // the limit is reduced to the maximum (default)
+	LimitError                    // Build returns ErrInvalidLimit
 )
 
 // StringOpts configures string matching behavior.
-// Zero values default to StringExact and Sensitive.
+// Zero values default to StringExact, Sensitive and PatternEscaped.
 type StringOpts struct {
-    Match       StringMatchType
-    Sensitivity StringSensitivityType
+	Match       StringMatchType
+	Sensitivity StringSensitivityType
+	Pattern     StringPatternType
 }
 
 // Dates represents a date range with optional after, before, and exact date times.
@@ -49,7 +76,32 @@ type StringOpts struct {
 // If both After and Before are set: query is "between After and Before"
 // If all are zero: returns empty condition
 type Dates struct {
-    On     time.Time // Exact date match (takes priority over After/Before)
-    After  time.Time // Range start (after this date)
-    Before time.Time // Range end (before this date)
+	On     time.Time // Exact date match (takes priority over After/Before)
+	After  time.Time // Range start (after this date)
+	Before time.Time // Range end (before this date)
+}
+
+// ComparisonOperator compares a column against a single value.
+type ComparisonOperator int
+
+const (
+	OpEq    ComparisonOperator = iota // =
+	OpNotEq                           // <>
+	OpGt                              // >
+	OpGte                             // >=
+	OpLt                              // <
+	OpLte                             // <=
+)
+
+// Range represents a numeric range with optional bounds.
+// Bounds are inclusive unless MinExclusive or MaxExclusive is set.
+// If only Min is set: query is "column >= Min" (or "> Min")
+// If only Max is set: query is "column <= Max" (or "< Max")
+// If both are set: query is "between Min and Max"
+// If neither is set: returns empty condition
+type Range[T any] struct {
+	Min          *T   // Lower bound, nil when unbounded
+	Max          *T   // Upper bound, nil when unbounded
+	MinExclusive bool // Exclude Min from the range
+	MaxExclusive bool // Exclude Max from the range
 }
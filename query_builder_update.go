@@ -0,0 +1,126 @@
+package querybuilder
+
+import (
+	"fmt"
+	"strings"
+)
+
+// UpdateBuilder builds UPDATE statements filtered with the same conditions
+// as QueryBuilder.Where.
+type UpdateBuilder struct {
+	statement
+	whereClause
+	table       string
+	assignments []assignment
+	returning   []string
+	allRows     bool
+}
+
+type assignment struct {
+	column string
+	value  any
+}
+
+// Update starts an UPDATE statement for table. Placeholders, quoting and
+// RETURNING follow the dialect set with WithDialect, the same as NewQueryBuilder.
+func Update(table string, opts ...Option) *UpdateBuilder {
+	ub := &UpdateBuilder{
+		statement:   newStatement(opts),
+		table:       table,
+		assignments: []assignment{},
+		returning:   []string{},
+	}
+
+	if err := validateColumnName(table); err != nil {
+		ub.errs = append(ub.errs, err)
+	}
+	return ub
+}
+
+// Set assigns value to column. Values are bound before the WHERE arguments.
+func (ub *UpdateBuilder) Set(column string, value any) *UpdateBuilder {
+	if err := validateColumnName(column); err != nil {
+		ub.errs = append(ub.errs, err)
+		return ub
+	}
+
+	ub.assignments = append(ub.assignments, assignment{column: column, value: value})
+	return ub
+}
+
+func (ub *UpdateBuilder) Where(conditions ...QueryCondition) *UpdateBuilder {
+	ub.addConditions(&ub.whereClause, "AND", conditions)
+	return ub
+}
+
+// AndWhere is an alias of Where that reads better next to OrWhere.
+func (ub *UpdateBuilder) AndWhere(conditions ...QueryCondition) *UpdateBuilder {
+	ub.addConditions(&ub.whereClause, "AND", conditions)
+	return ub
+}
+
+// OrWhere adds conditions joined to the previous ones with OR.
+func (ub *UpdateBuilder) OrWhere(conditions ...QueryCondition) *UpdateBuilder {
+	ub.addConditions(&ub.whereClause, "OR", conditions)
+	return ub
+}
+
+// AllRows allows the statement to be built without any WHERE condition.
+func (ub *UpdateBuilder) AllRows() *UpdateBuilder {
+	ub.allRows = true
+	return ub
+}
+
+// Returning sets the columns returned for each updated row. SQL Server
+// renders an OUTPUT clause; MySQL has no equivalent and reports an error.
+func (ub *UpdateBuilder) Returning(columns ...string) *UpdateBuilder {
+	ub.returning = append(ub.returning, ub.returningColumns(columns)...)
+	return ub
+}
+
+// Build returns the UPDATE statement and its values. An UPDATE without
+// conditions fails with ErrMissingWhere unless AllRows was called; empty
+// conditions do not count, so optional filters cannot widen it to every row.
+func (ub *UpdateBuilder) Build() (string, []any, error) {
+	if err := ub.Err(); err != nil {
+		return "", nil, err
+	}
+
+	if len(ub.assignments) == 0 {
+		return "", nil, fmt.Errorf("%w: update of %s has no columns to set", ErrInvalidStatement, ub.table)
+	}
+
+	if len(ub.conditions) == 0 && !ub.allRows {
+		return "", nil, fmt.Errorf("%w: update of %s would change every row, call AllRows to allow it", ErrMissingWhere, ub.table)
+	}
+
+	ub.reset()
+
+	sets := make([]string, len(ub.assignments))
+	for i, a := range ub.assignments {
+		sets[i] = ub.ident(a.column) + " = " + ub.bindValue(a.value)
+	}
+
+	query := "UPDATE " + ub.ident(ub.table) + " SET " + strings.Join(sets, ", ")
+
+	returning, output := ub.returningClause("UPDATE", ub.returning)
+	if output {
+		query += returning
+	}
+
+	query += ub.renderWhere(ub.whereClause)
+
+	if !output {
+		query += returning
+	}
+	return query + ";", ub.values, nil
+}
+
+// MustBuild is like Build but panics if the statement cannot be built.
+func (ub *UpdateBuilder) MustBuild() (string, []any) {
+	query, values, err := ub.Build()
+	if err != nil {
+		panic(err)
+	}
+	return query, values
+}
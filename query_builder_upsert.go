@@ -0,0 +1,120 @@
+package querybuilder
+
+import (
+	"fmt"
+	"strings"
+)
+
+// conflictAction is what an upsert does with a row that conflicts with an
+// existing one.
+type conflictAction int
+
+const (
+	conflictNone conflictAction = iota
+	conflictDoNothing
+	conflictDoUpdate
+)
+
+// upsert holds the ON CONFLICT clause of an InsertBuilder.
+type upsert struct {
+	target  []string
+	action  conflictAction
+	updates []string
+	where   whereClause
+}
+
+// OnConflict sets the conflict target columns of an upsert, i.e. the columns
+// of a unique index or constraint, without a table prefix. Follow it with
+// DoNothing or DoUpdate.
+func (ib *InsertBuilder) OnConflict(columns ...string) *InsertBuilder {
+	for _, column := range columns {
+		if err := validateUnqualifiedColumn(column); err != nil {
+			ib.errs = append(ib.errs, err)
+			continue
+		}
+		ib.conflict.target = append(ib.conflict.target, column)
+	}
+	return ib
+}
+
+// DoNothing skips rows that conflict with an existing row. The conflict
+// target is optional.
+func (ib *InsertBuilder) DoNothing() *InsertBuilder {
+	ib.conflict.action = conflictDoNothing
+	ib.checkOnConflict()
+	return ib
+}
+
+// DoUpdate updates the given columns of the existing row with the values of
+// the row being inserted: col = EXCLUDED.col. Columns have no table prefix.
+// It requires a conflict target.
+func (ib *InsertBuilder) DoUpdate(columns ...string) *InsertBuilder {
+	ib.conflict.action = conflictDoUpdate
+	ib.checkOnConflict()
+
+	for _, column := range columns {
+		if err := validateUnqualifiedColumn(column); err != nil {
+			ib.errs = append(ib.errs, err)
+			continue
+		}
+		ib.conflict.updates = append(ib.conflict.updates, column)
+	}
+	return ib
+}
+
+// DoUpdateWhere adds conditions on the DO UPDATE clause, e.g. to only
+// update rows that are older than the one being inserted. Values are bound
+// after the inserted values.
+func (ib *InsertBuilder) DoUpdateWhere(conditions ...QueryCondition) *InsertBuilder {
+	ib.addConditions(&ib.conflict.where, "AND", conditions)
+	return ib
+}
+
+// checkOnConflict collects an error when the dialect cannot upsert with
+// ON CONFLICT.
+func (ib *InsertBuilder) checkOnConflict() {
+	if ib.dialect.OnConflict(nil) == "" {
+		ib.errs = append(ib.errs, fmt.Errorf("%w: ON CONFLICT", ErrUnsupportedByDialect))
+	}
+}
+
+// validateConflict checks the ON CONFLICT clause is complete.
+func (ib *InsertBuilder) validateConflict() error {
+	c := ib.conflict
+	switch {
+	case c.action == conflictNone && len(c.target) > 0:
+		return fmt.Errorf("%w: ON CONFLICT on %s needs DoNothing or DoUpdate", ErrInvalidStatement, ib.table)
+	case c.action == conflictDoUpdate && len(c.target) == 0:
+		return fmt.Errorf("%w: DO UPDATE on %s needs a conflict target", ErrInvalidStatement, ib.table)
+	case c.action == conflictDoUpdate && len(c.updates) == 0:
+		return fmt.Errorf("%w: DO UPDATE on %s has no columns to update", ErrInvalidStatement, ib.table)
+	case c.action != conflictDoUpdate && len(c.where.conditions) > 0:
+		return fmt.Errorf("%w: DoUpdateWhere on %s needs DoUpdate", ErrInvalidStatement, ib.table)
+	}
+	return nil
+}
+
+// renderConflict renders the ON CONFLICT clause with its leading space, or
+// nothing when the statement is a plain INSERT.
+func (ib *InsertBuilder) renderConflict() string {
+	c := ib.conflict
+	if c.action == conflictNone {
+		return ""
+	}
+
+	target := make([]string, len(c.target))
+	for i, column := range c.target {
+		target[i] = ib.ident(column)
+	}
+	clause := ib.dialect.OnConflict(target)
+
+	if c.action == conflictDoNothing {
+		return clause + " DO NOTHING"
+	}
+
+	sets := make([]string, len(c.updates))
+	for i, column := range c.updates {
+		sets[i] = ib.ident(column) + " = EXCLUDED." + ib.ident(column)
+	}
+	return clause + " DO UPDATE SET " + strings.Join(sets, ", ") + ib.renderWhere(c.where)
+}
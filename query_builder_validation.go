@@ -1,15 +1,80 @@
 package querybuilder
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
 )
 
+var (
+	// ErrInvalidColumnName is returned when a column name fails validation.
+	ErrInvalidColumnName = errors.New("invalid column name")
+	// ErrUnsupportedValue is returned when a matcher receives a value or option it cannot bind.
+	ErrUnsupportedValue = errors.New("unsupported value")
+	// ErrArgumentCount is returned when a raw condition's markers and arguments do not match.
+	ErrArgumentCount = errors.New("argument count mismatch")
+	// ErrInvalidStatement is returned when a statement is missing a required part.
+	ErrInvalidStatement = errors.New("invalid statement")
+	// ErrInvalidCursor is returned when a keyset pagination cursor cannot be encoded or decoded.
+	ErrInvalidCursor = errors.New("invalid cursor")
+	// ErrInvalidLimit is returned for a negative limit or offset, or a limit above the maximum with LimitError.
+	ErrInvalidLimit = errors.New("invalid limit")
+	// ErrInvalidSort is returned by ParseSort for unknown fields and malformed sort specifications.
+	ErrInvalidSort = errors.New("invalid sort")
+	// ErrMissingWhere is returned when an UPDATE or DELETE has no conditions and was not allowed to affect every row.
+	ErrMissingWhere = errors.New("missing WHERE clause")
+	// ErrTooManyParameters is returned when a statement exceeds the dialect's parameter limit.
+	ErrTooManyParameters = errors.New("too many parameters")
+	// ErrUnsupportedByDialect is returned when a clause is not supported by the dialect.
+	ErrUnsupportedByDialect = errors.New("unsupported by dialect")
+)
+
 var columnNameRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_.]*$`)
 
+// functionRegex matches the expressions built by Count, Sum, Avg, Min, Max
+// and Lower.
+var functionRegex = regexp.MustCompile(`^(COUNT|SUM|AVG|MIN|MAX|LOWER)\(([a-zA-Z_][a-zA-Z0-9_.]*|\*)\)$`)
+
+var aliasRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+var decimalRegex = regexp.MustCompile(`^[+-]?([0-9]+(\.[0-9]*)?|\.[0-9]+)$`)
+
 func validateColumnName(column string) error {
 	if column == "" || !columnNameRegex.MatchString(column) {
-		return fmt.Errorf("invalid column name: %s (must contain only letters, numbers, underscores, and dots)", column)
+		return fmt.Errorf("%w: %s (must contain only letters, numbers, underscores, and dots)", ErrInvalidColumnName, column)
+	}
+	return nil
+}
+
+// validateColumnRef validates a column name or an aggregate or LOWER of one,
+// so the same matchers work in WHERE and HAVING and on expressions. Where an
+// aggregate is not valid, conditions are checked with isAggregate.
+func validateColumnRef(column string) error {
+	if m := functionRegex.FindStringSubmatch(column); m != nil && (m[2] != "*" || m[1] == "COUNT") {
+		return nil
+	}
+	return validateColumnName(column)
+}
+
+// isAggregate reports whether column is an aggregate built by Count, Sum,
+// Avg, Min or Max.
+func isAggregate(column string) bool {
+	m := functionRegex.FindStringSubmatch(column)
+	return m != nil && m[1] != "LOWER"
+}
+
+// validateUnqualifiedColumn validates a column name without a table prefix,
+// as required where the column belongs to the statement's own table.
+func validateUnqualifiedColumn(column string) error {
+	if !aliasRegex.MatchString(column) {
+		return fmt.Errorf("%w: %s (must contain only letters, numbers and underscores, without a table prefix)", ErrInvalidColumnName, column)
+	}
+	return nil
+}
+
+func validateAlias(alias string) error {
+	if !aliasRegex.MatchString(alias) {
+		return fmt.Errorf("%w: %s (aliases must contain only letters, numbers and underscores)", ErrInvalidColumnName, alias)
 	}
 	return nil
 }
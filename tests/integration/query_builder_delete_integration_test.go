@@ -0,0 +1,36 @@
+package querybuilder_test
+
+import (
+	"testing"
+
+	. "github.com/bolanosdev/query-builder"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryBuilder_Integration_DeleteReturning(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	query, values := Delete("accounts", WithDialect(SQLite)).
+		Where(ByIntComparison("id", OpGt, 45)).
+		Returning("name").
+		MustBuild()
+
+	rows, err := db.Query(query, values...)
+	if err != nil {
+		t.Fatalf("Query failed: %v\nQuery: %s", err, query)
+	}
+
+	names := []string{}
+	for rows.Next() {
+		var name string
+		require.NoError(t, rows.Scan(&name))
+		names = append(names, name)
+	}
+	rows.Close()
+	require.ElementsMatch(t, []string{"quentin", "rosa", "steve", "tracy", "ursula"}, names)
+
+	u := executeWhereQuery(t, db, ByIntComparison("id", OpGt, 40))
+	require.Equal(t, []int{41, 42, 43, 44, 45}, mapUserIDs(u))
+}
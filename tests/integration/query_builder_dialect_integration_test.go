@@ -0,0 +1,34 @@
+package querybuilder_test
+
+import (
+	"testing"
+
+	. "github.com/bolanosdev/query-builder"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryBuilder_Integration_SQLiteDialect(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	qb := NewQueryBuilder("select * from accounts", WithDialect(SQLite), WithQuotedIdentifiers())
+	finalQuery, values := qb.Where(
+		ByIntColumn("id", []int{1, 2, 3, 4}),
+		ByStringColumn("name", []string{"a"}, StringContains),
+	).SortBy(Sort("id", SortDesc)).Limit(2).Offset(1).Commit()
+
+	rows, err := db.Query(finalQuery, values...)
+	if err != nil {
+		t.Fatalf("Query failed: %v\nQuery: %s", err, finalQuery)
+	}
+	defer rows.Close()
+
+	u, err := fetchAllUsers(rows)
+	if err != nil {
+		t.Fatalf("Failed to fetch users: %v", err)
+	}
+
+	// carlos, jane and alice contain "a"; sorted by id DESC and skipping alice
+	require.Equal(t, []int{3, 1}, mapUserIDs(u))
+}
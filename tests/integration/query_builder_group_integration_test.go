@@ -0,0 +1,39 @@
+package querybuilder_test
+
+import (
+	"testing"
+
+	. "github.com/bolanosdev/query-builder"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryBuilder_Integration_GroupByHaving(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	setupPostsTable(t, db)
+
+	query, values := NewQueryBuilder("", WithDialect(SQLite)).
+		Select("p.author_id", Count("*")+" AS posts", Sum("p.score")+" AS total").
+		From("posts AS p").
+		Where(ByIntComparison("p.score", OpGt, 1)).
+		GroupBy("p.author_id").
+		Having(ByIntComparison(Sum("p.score"), OpGte, 25)).
+		SortBy(Sort(Sum("p.score"), SortDesc)).
+		Commit()
+
+	rows, err := db.Query(query, values...)
+	if err != nil {
+		t.Fatalf("Query failed: %v\nQuery: %s", err, query)
+	}
+	defer rows.Close()
+
+	type total struct{ author, posts, score int }
+	got := []total{}
+	for rows.Next() {
+		var r total
+		require.NoError(t, rows.Scan(&r.author, &r.posts, &r.score))
+		got = append(got, r)
+	}
+	require.Equal(t, []total{{99, 1, 50}, {2, 1, 30}, {1, 2, 25}}, got)
+}
@@ -0,0 +1,57 @@
+package querybuilder_test
+
+import (
+	"testing"
+
+	. "github.com/bolanosdev/query-builder"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryBuilder_Integration_InsertReturning(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	query, values := Insert("accounts", WithDialect(SQLite)).
+		Columns("name", "created_at").
+		Values("zoe", "2024-03-01 10:00:00").
+		Values("yuri", "2024-03-02 10:00:00").
+		Returning("id").
+		MustBuild()
+
+	rows, err := db.Query(query, values...)
+	if err != nil {
+		t.Fatalf("Query failed: %v\nQuery: %s", err, query)
+	}
+	defer rows.Close()
+
+	ids := []int{}
+	for rows.Next() {
+		var id int
+		require.NoError(t, rows.Scan(&id))
+		ids = append(ids, id)
+	}
+	require.Equal(t, []int{51, 52}, ids)
+}
+
+func TestQueryBuilder_Integration_InsertBatches(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	ib := Insert("accounts", WithDialect(SQLite), WithMaxParams(10)).Columns("id", "name")
+	for id := 100; id < 112; id++ {
+		ib.Values(id, "batch")
+	}
+
+	batches, err := ib.BuildBatches()
+	require.NoError(t, err)
+	require.Len(t, batches, 3)
+
+	for _, batch := range batches {
+		_, err := db.Exec(batch.Query, batch.Values...)
+		require.NoError(t, err, batch.Query)
+	}
+
+	u := executeWhereQuery(t, db, ByStringColumn("name", []string{"batch"}))
+	require.Len(t, u, 12)
+}
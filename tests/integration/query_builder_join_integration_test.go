@@ -0,0 +1,75 @@
+package querybuilder_test
+
+import (
+	"database/sql"
+	"testing"
+
+	. "github.com/bolanosdev/query-builder"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func setupPostsTable(t *testing.T, db *sql.DB) {
+	t.Helper()
+
+	_, err := db.Exec(`
+		CREATE TABLE posts (
+			id INTEGER PRIMARY KEY,
+			author_id INTEGER NOT NULL,
+			score INTEGER NOT NULL
+		);
+		INSERT INTO posts (id, author_id, score) VALUES
+		(1, 1, 5), (2, 1, 20), (3, 2, 30), (4, 3, 1), (5, 99, 50)
+	`)
+	if err != nil {
+		t.Fatalf("Failed to create posts: %v", err)
+	}
+}
+
+func TestQueryBuilder_Integration_JoinWithConditions(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	setupPostsTable(t, db)
+
+	query, values := NewQueryBuilder("", WithDialect(SQLite)).
+		Select("a.id", "a.name", "a.created_at").
+		From("accounts AS a").
+		Join("posts AS p", On("p.author_id", "a.id"), ByIntComparison("p.score", OpGte, 10)).
+		Where(Not(ByStringColumn("a.name", []string{"john"}))).
+		SortBy(Sort("a.id")).
+		Commit()
+
+	rows, err := db.Query(query, values...)
+	if err != nil {
+		t.Fatalf("Query failed: %v\nQuery: %s", err, query)
+	}
+	defer rows.Close()
+
+	u, err := fetchAllUsers(rows)
+	require.NoError(t, err)
+	require.Equal(t, []int{1}, mapUserIDs(u))
+}
+
+func TestQueryBuilder_Integration_LeftJoinWithoutMatch(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	setupPostsTable(t, db)
+
+	query, values := NewQueryBuilder("", WithDialect(SQLite)).
+		Select("a.id", "a.name", "a.created_at").
+		From("accounts AS a").
+		LeftJoin("posts AS p", On("p.author_id", "a.id")).
+		Where(IsNull("p.id"), ByIntComparison("a.id", OpLte, 5)).
+		SortBy(Sort("a.id")).
+		Commit()
+
+	rows, err := db.Query(query, values...)
+	if err != nil {
+		t.Fatalf("Query failed: %v\nQuery: %s", err, query)
+	}
+	defer rows.Close()
+
+	u, err := fetchAllUsers(rows)
+	require.NoError(t, err)
+	require.Equal(t, []int{4, 5}, mapUserIDs(u))
+}
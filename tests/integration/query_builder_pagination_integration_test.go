@@ -107,3 +107,106 @@ func TestQueryBuilder_Integration_CompleteQuery(t *testing.T) {
 	require.Equal(t, u[2].ID, 5)
 	require.Equal(t, u[2].Name, "bob")
 }
+
+func TestQueryBuilder_Integration_CountQuery(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	builders := map[string]*QueryBuilder{
+		"base query": NewQueryBuilder("select * from accounts"),
+		"structured": NewQueryBuilder("", WithDialect(SQLite)).Select().From("accounts"),
+	}
+
+	for name, qb := range builders {
+		t.Run(name, func(t *testing.T) {
+			qb.Where(ByStringColumn("name", []string{"a"}, StringContains)).SortBy(Sort("id")).Limit(5).Offset(5)
+
+			query, values, err := qb.CountQuery()
+			require.NoError(t, err)
+
+			var total int
+			require.NoError(t, db.QueryRow(query, values...).Scan(&total), query)
+			require.Equal(t, 38, total)
+		})
+	}
+}
+
+func TestQueryBuilder_Integration_KeysetPagination(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	cases := map[string]struct {
+		sorts []SortField
+		first int
+		last  int
+	}{
+		// Same direction compares row values, mixed directions expand to an OR-chain
+		"row value": {[]SortField{Sort("name", SortDesc), Sort("id", SortDesc)}, 29, 4},
+		"or-chain":  {[]SortField{Sort("name"), Sort("id", SortDesc)}, 4, 29},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			cursor := ""
+			ids := []int{}
+			pages := 0
+
+			for {
+				qb := NewQueryBuilder("select * from accounts", WithDialect(SQLite)).
+					Where(ByStringColumn("name", []string{"a"}, StringContains)).
+					SortBy(c.sorts...).
+					AfterCursor(cursor).
+					Limit(15)
+
+				query, values := qb.Commit()
+				rows, err := db.Query(query, values...)
+				require.NoError(t, err, query)
+				u, err := fetchAllUsers(rows)
+				rows.Close()
+				require.NoError(t, err)
+
+				if len(u) == 0 {
+					break
+				}
+				pages++
+				ids = append(ids, mapUserIDs(u)...)
+
+				last := u[len(u)-1]
+				cursor, err = qb.NextCursor(map[string]any{"id": last.ID, "name": last.Name})
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, 3, pages)
+			require.Len(t, ids, 38)
+			require.Equal(t, c.first, ids[0])
+			require.Equal(t, c.last, ids[len(ids)-1])
+		})
+	}
+}
+
+func TestQueryBuilder_Integration_Paginate(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	qb := NewQueryBuilder("select * from accounts", WithMaxPageSize(20)).
+		Where(ByIntComparison("id", OpLte, 45)).
+		SortBy(Sort("id")).
+		Paginate(3, 50)
+
+	query, values := qb.Commit()
+	rows, err := db.Query(query, values...)
+	require.NoError(t, err, query)
+	defer rows.Close()
+
+	u, err := fetchAllUsers(rows)
+	require.NoError(t, err)
+	require.Len(t, u, 5)
+	require.Equal(t, 41, u[0].ID)
+
+	countQuery, countValues, err := qb.CountQuery()
+	require.NoError(t, err)
+
+	var total int
+	require.NoError(t, db.QueryRow(countQuery, countValues...).Scan(&total))
+	require.Equal(t, PageInfo{Page: 3, PageSize: 20, Total: 45, TotalPages: 3, HasNext: false, HasPrev: true}, qb.PageInfo(total))
+}
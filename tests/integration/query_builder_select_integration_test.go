@@ -0,0 +1,42 @@
+package querybuilder_test
+
+import (
+	"testing"
+
+	. "github.com/bolanosdev/query-builder"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryBuilder_Integration_SelectFields(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	allowed := map[string]string{"id": "a.id", "username": "a.name", "created": "a.created_at"}
+
+	query, values := NewQueryBuilder("", WithDialect(SQLite), WithQuotedIdentifiers()).
+		SelectFields([]string{"username", "id"}, allowed).
+		From("accounts AS a").
+		Where(ByIntColumn("a.id", []int{1, 2})).
+		SortBy(Sort("id")).
+		Commit()
+
+	rows, err := db.Query(query, values...)
+	if err != nil {
+		t.Fatalf("Query failed: %v\nQuery: %s", err, query)
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	require.NoError(t, err)
+	require.Equal(t, []string{"username", "id"}, columns)
+
+	names := []string{}
+	for rows.Next() {
+		var name string
+		var id int
+		require.NoError(t, rows.Scan(&name, &id))
+		names = append(names, name)
+	}
+	require.Equal(t, []string{"carlos", "john"}, names)
+}
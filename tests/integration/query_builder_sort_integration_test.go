@@ -1,6 +1,7 @@
 package querybuilder_test
 
 import (
+	"database/sql"
 	"testing"
 
 	. "github.com/bolanosdev/query-builder"
@@ -67,3 +68,70 @@ func TestQueryBuilder_Integration_SortMultiple(t *testing.T) {
 	require.Equal(t, 50, len(ids))
 	require.Equal(t, []int{4, 30, 5, 31, 1}, ids[:5])
 }
+
+func TestQueryBuilder_Integration_SortNullsLast(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	_, err := db.Exec("UPDATE accounts SET created_at = NULL WHERE id IN (48, 49, 50)")
+	require.NoError(t, err)
+
+	// SQLite renders NULLS LAST natively; MySQL's emulation also runs on SQLite
+	for _, dialect := range []Dialect{SQLite, MySQL} {
+		query, values := NewQueryBuilder("select id from accounts", WithDialect(dialect)).
+			SortBy(Sort("created_at", SortDesc).NullsLast(), Sort("id")).
+			Commit()
+
+		ids := queryIDs(t, db, query, values)
+		require.Equal(t, 50, len(ids))
+		require.Equal(t, []int{47, 46}, ids[:2])
+		require.Equal(t, []int{48, 49, 50}, ids[47:])
+	}
+}
+
+func TestQueryBuilder_Integration_SortPriorityAndLower(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	_, err := db.Exec("UPDATE accounts SET name = 'Zed' WHERE id = 1")
+	require.NoError(t, err)
+
+	query, values := NewQueryBuilder("select id from accounts", WithDialect(SQLite)).
+		Where(ByIntComparison("id", OpLte, 6)).
+		SortBy(SortPriority("name", []string{"bob", "jane"}), Sort(Lower("name"), SortDesc)).
+		Commit()
+
+	// bob and jane first, then by name descending; without LOWER "Zed" would sort last
+	require.Equal(t, []int{5, 3, 1, 2, 6, 4}, queryIDs(t, db, query, values))
+}
+
+func queryIDs(t *testing.T, db *sql.DB, query string, values []any) []int {
+	t.Helper()
+
+	rows, err := db.Query(query, values...)
+	if err != nil {
+		t.Fatalf("Query failed: %v\nQuery: %s", err, query)
+	}
+	defer rows.Close()
+
+	ids := []int{}
+	for rows.Next() {
+		var id int
+		require.NoError(t, rows.Scan(&id))
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func TestQueryBuilder_Integration_ParseSort(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	fields, err := ParseSort("-created_at,name", map[string]string{"created_at": "created_at", "name": "name"})
+	require.NoError(t, err)
+
+	u := executeSortQuery(t, db, fields...)
+
+	// Most recent first (ursula, tracy, steve)
+	require.Equal(t, []int{50, 49, 48}, mapUserIDs(u)[:3])
+}
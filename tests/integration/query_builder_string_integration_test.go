@@ -90,3 +90,33 @@ func TestQueryBuilder_Integration_StringCaseInsensitiveEndsWith(t *testing.T) {
 
 	require.Equal(t, []int{2, 17, 20, 31, 40, 46}, ids)
 }
+
+func TestQueryBuilder_Integration_StringWildcardsEscaped(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	_, err := db.Exec(`INSERT INTO accounts (id, name, created_at) VALUES
+		(51, '50%', '2024-03-01 10:00:00'),
+		(52, '500', '2024-03-02 10:00:00'),
+		(53, 'a_b', '2024-03-03 10:00:00'),
+		(54, 'axb', '2024-03-04 10:00:00'),
+		(55, 'ok!', '2024-03-05 10:00:00'),
+		(56, 'x[y]z', '2024-03-06 10:00:00')`)
+	require.NoError(t, err)
+
+	u := executeWhereQuery(t, db, ByStringColumn("name", []string{"50%"}, StringStartsWith))
+	require.Equal(t, []int{51}, mapUserIDs(u))
+
+	u = executeWhereQuery(t, db, ByStringColumn("name", []string{"_"}, StringContains))
+	require.Equal(t, []int{53}, mapUserIDs(u))
+
+	u = executeWhereQuery(t, db, ByStringColumn("name", []string{"K!"}, StringEndsWith, NonSensitive))
+	require.Equal(t, []int{55}, mapUserIDs(u))
+
+	u = executeWhereQuery(t, db, ByStringColumn("name", []string{"x[y]"}, StringStartsWith))
+	require.Equal(t, []int{56}, mapUserIDs(u))
+
+	// Raw patterns keep their wildcard meaning
+	u = executeWhereQuery(t, db, ByStringColumn("name", []string{"a_b"}, StringContains, PatternRaw))
+	require.Equal(t, []int{53, 54}, mapUserIDs(u))
+}
@@ -0,0 +1,38 @@
+package querybuilder_test
+
+import (
+	"testing"
+
+	. "github.com/bolanosdev/query-builder"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryBuilder_Integration_UpdateReturning(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	low, high := 2, 4
+	query, values := Update("accounts", WithDialect(SQLite)).
+		Set("name", "renamed").
+		Where(ByIntRange("id", Range[int]{Min: &low, Max: &high}), Not(ByStringColumn("name", []string{"jane"}))).
+		Returning("id").
+		MustBuild()
+
+	rows, err := db.Query(query, values...)
+	if err != nil {
+		t.Fatalf("Query failed: %v\nQuery: %s", err, query)
+	}
+
+	ids := []int{}
+	for rows.Next() {
+		var id int
+		require.NoError(t, rows.Scan(&id))
+		ids = append(ids, id)
+	}
+	rows.Close()
+	require.ElementsMatch(t, []int{2, 4}, ids)
+
+	u := executeWhereQuery(t, db, ByStringColumn("name", []string{"renamed"}))
+	require.ElementsMatch(t, []int{2, 4}, mapUserIDs(u))
+}
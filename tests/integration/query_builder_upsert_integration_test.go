@@ -0,0 +1,50 @@
+package querybuilder_test
+
+import (
+	"testing"
+
+	. "github.com/bolanosdev/query-builder"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryBuilder_Integration_UpsertDoNothing(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	query, values := Insert("accounts", WithDialect(SQLite)).
+		Columns("id", "name").
+		Values(1, "duplicate").
+		Values(60, "new").
+		OnConflict("id").
+		DoNothing().
+		MustBuild()
+
+	_, err := db.Exec(query, values...)
+	require.NoError(t, err, query)
+
+	u := executeWhereQuery(t, db, ByIntColumn("id", []int{1, 60}))
+	require.Equal(t, "carlos", u[0].Name)
+	require.Equal(t, "new", u[1].Name)
+}
+
+func TestQueryBuilder_Integration_UpsertDoUpdateWhere(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	query, values := Insert("accounts", WithDialect(SQLite)).
+		Columns("id", "name").
+		Values(1, "carlos2").
+		Values(2, "john2").
+		OnConflict("id").
+		DoUpdate("name").
+		DoUpdateWhere(Not(ByStringColumn("accounts.name", []string{"john"}))).
+		MustBuild()
+
+	_, err := db.Exec(query, values...)
+	require.NoError(t, err, query)
+
+	u := executeWhereQuery(t, db, ByIntColumn("id", []int{1, 2}))
+	require.Equal(t, "carlos2", u[0].Name)
+	require.Equal(t, "john", u[1].Name)
+}
@@ -94,3 +94,147 @@ func TestQueryBuilder_Integration_NestedConditions(t *testing.T) {
 
 	require.Equal(t, []int{1}, ids)
 }
+
+func TestQueryBuilder_Integration_OptionalFiltersOmitted(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	u := executeWhereQuery(t, db,
+		ByIntColumn("id", []int{}),
+		Or(ByStringColumn("name", nil), ByDateColumn("created_at", Dates{})),
+		ByStringColumn("name", []string{"john"}),
+	)
+	ids := mapUserIDs(u)
+
+	require.Equal(t, []int{2}, ids)
+}
+
+func TestQueryBuilder_Integration_DeeplyNestedConditions(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	u := executeWhereQuery(t, db,
+		Or(
+			ByIntColumn("id", []int{1}),
+			And(
+				ByStringColumn("name", []string{"j"}, StringStartsWith),
+				Or(ByIntColumn("id", []int{3}), And(ByIntColumn("id", []int{13, 39}), ByStringColumn("name", []string{"james"}))),
+			),
+		),
+	)
+	ids := mapUserIDs(u)
+
+	require.Equal(t, []int{1, 3, 39}, ids)
+}
+
+func TestQueryBuilder_Integration_NotConditions(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	u := executeWhereQuery(t, db,
+		Not(ByIntColumn("id", []int{1, 2, 3})),
+		ByStringColumn("name", []string{"j"}, StringStartsWith),
+		Not(Or(ByStringColumn("name", []string{"jack"}), ByStringColumn("name", []string{"z"}, StringContains))),
+	)
+	ids := mapUserIDs(u)
+
+	// john and jane are excluded by id, jack by name
+	require.Equal(t, []int{39}, ids)
+}
+
+func TestQueryBuilder_Integration_OrWhere(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	qb := NewQueryBuilder("select * from accounts")
+	finalQuery, values := qb.
+		Where(ByIntColumn("id", []int{1})).
+		OrWhere(ByStringColumn("name", []string{"john"})).
+		OrWhere(ByStringColumn("name", []string{"zack"})).
+		Commit()
+
+	rows, err := db.Query(finalQuery, values...)
+	if err != nil {
+		t.Fatalf("Query failed: %v\nQuery: %s", err, finalQuery)
+	}
+	defer rows.Close()
+
+	u, err := fetchAllUsers(rows)
+	if err != nil {
+		t.Fatalf("Failed to fetch users: %v", err)
+	}
+
+	require.Equal(t, []int{1, 2, 29}, mapUserIDs(u))
+}
+
+func TestQueryBuilder_Integration_IntComparisonAndRange(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	low, high := 10, 13
+	u := executeWhereQuery(t, db,
+		Or(
+			ByIntRange("id", Range[int]{Min: &low, Max: &high, MaxExclusive: true}),
+			ByIntComparison("id", OpGte, 49),
+		),
+	)
+	ids := mapUserIDs(u)
+
+	require.Equal(t, []int{10, 11, 12, 49, 50}, ids)
+}
+
+func TestQueryBuilder_Integration_FloatAndDecimal(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	low, high := 2.5, 5.5
+	u := executeWhereQuery(t, db,
+		ByFloatRange("id", Range[float64]{Min: &low, Max: &high}),
+		Not(ByDecimalColumn("id", []string{"4"})),
+	)
+	ids := mapUserIDs(u)
+
+	require.Equal(t, []int{3, 5}, ids)
+}
+
+func TestQueryBuilder_Integration_NullAndBool(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	u := executeWhereQuery(t, db, IsNotNull("created_at"), ByIntColumn("id", []int{1, 2}))
+	require.Equal(t, []int{1, 2}, mapUserIDs(u))
+
+	u = executeWhereQuery(t, db, IsNull("created_at"))
+	require.Empty(t, u)
+
+	// SQLite stores booleans as integers, so TRUE matches id 1
+	u = executeWhereQuery(t, db, ByBoolColumn("id", true))
+	require.Equal(t, []int{1}, mapUserIDs(u))
+}
+
+func TestQueryBuilder_Integration_ByColumn(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	u := executeWhereQuery(t, db, ByColumn("id", []int64{5, 6, 7}), Not(ByColumn("name", []string{"charlie"})))
+	ids := mapUserIDs(u)
+
+	require.Equal(t, []int{5, 7}, ids)
+}
+
+func TestQueryBuilder_Integration_Raw(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	u := executeWhereQuery(t, db,
+		Or(
+			Raw("id * 2 = ? OR length(name) > ?", 8, 6),
+			Raw("id IN ?", []int{1, 2}),
+		),
+		ByIntComparison("id", OpLt, 20),
+	)
+	ids := mapUserIDs(u)
+
+	// ids 1 and 2, id 4 (4 * 2 = 8) and charlie, the only name below id 20 longer than six characters
+	require.Equal(t, []int{1, 2, 4, 6}, ids)
+}
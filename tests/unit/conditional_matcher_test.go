@@ -77,3 +77,180 @@ func TestQueryBuilder_NestedConditions(t *testing.T) {
 		t.Errorf("Expected: %s\nGot: %s", expected, result)
 	}
 }
+
+func TestQueryBuilder_EmptyConditionsSkipped(t *testing.T) {
+	query := "select * from accounts"
+	qb := NewQueryBuilder(query)
+	result, values := qb.Where(
+		ByIntColumn("id", []int{}),
+		ByStringColumn("name", []string{"carlos"}),
+		ByDateColumn("created_at", Dates{}),
+	).Commit()
+
+	expected := "select * from accounts WHERE name = $1;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+
+	if len(values) != 1 || values[0] != "carlos" {
+		t.Errorf("Expected [carlos], got %v", values)
+	}
+}
+
+func TestQueryBuilder_AllConditionsEmpty(t *testing.T) {
+	query := "select * from accounts"
+	qb := NewQueryBuilder(query)
+	result, values := qb.Where(ByIntColumn("id", nil), ByStringColumn("name", nil)).Commit()
+
+	expected := "select * from accounts;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+
+	if len(values) != 0 {
+		t.Errorf("Expected 0 values, got %d", len(values))
+	}
+}
+
+func TestQueryBuilder_EmptyConditionsInsideGroup(t *testing.T) {
+	query := "select * from accounts"
+	qb := NewQueryBuilder(query)
+	result, values := qb.Where(
+		ByIntColumn("id", []int{1}),
+		Or(ByStringColumn("name", []string{}), ByIntColumn("id", []int{2})),
+	).Commit()
+
+	expected := "select * from accounts WHERE id = $1 AND (id = $2);"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+
+	if len(values) != 2 {
+		t.Errorf("Expected 2 values, got %d", len(values))
+	}
+}
+
+func TestQueryBuilder_EmptyGroupDropped(t *testing.T) {
+	query := "select * from accounts"
+	qb := NewQueryBuilder(query)
+	result, values := qb.Where(
+		Or(ByStringColumn("name", []string{}), ByDateColumn("created_at", Dates{})),
+		ByIntColumn("id", []int{1}),
+		And(Or(ByIntColumn("id", nil))),
+	).Commit()
+
+	expected := "select * from accounts WHERE id = $1;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+
+	if len(values) != 1 {
+		t.Errorf("Expected 1 value, got %d", len(values))
+	}
+}
+
+func TestQueryBuilder_DeeplyNestedGroups(t *testing.T) {
+	query := "select * from documents"
+	qb := NewQueryBuilder(query)
+	result, values := qb.Where(
+		Or(
+			ByIntColumn("owner_id", []int{7}),
+			And(
+				ByIntColumn("shared", []int{1}),
+				Or(
+					ByStringColumn("role", []string{"editor", "admin"}),
+					And(ByIntColumn("team_id", []int{3}), ByStringColumn("role", []string{"viewer"})),
+				),
+			),
+		),
+		ByIntColumn("archived", []int{0}),
+	).Commit()
+
+	expected := "select * from documents WHERE (owner_id = $1 OR (shared = $2 AND (role IN ($3, $4) OR (team_id = $5 AND role = $6)))) AND archived = $7;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+
+	expectedValues := []any{7, 1, "editor", "admin", 3, "viewer", 0}
+	if len(values) != len(expectedValues) {
+		t.Fatalf("Expected %d values, got %d", len(expectedValues), len(values))
+	}
+	for i := range expectedValues {
+		if values[i] != expectedValues[i] {
+			t.Errorf("Expected value %v at %d, got %v", expectedValues[i], i, values[i])
+		}
+	}
+}
+
+func TestQueryBuilder_NestedGroupsWithOtherDialect(t *testing.T) {
+	query := "select * from accounts"
+	qb := NewQueryBuilder(query, WithDialect(SQLServer))
+	result, _ := qb.Where(
+		And(ByIntColumn("id", []int{1}), Or(ByIntColumn("id", []int{2}), And(ByIntColumn("id", []int{3}), ByIntColumn("id", []int{4})))),
+	).Commit()
+
+	expected := "select * from accounts WHERE (id = @p1 AND (id = @p2 OR (id = @p3 AND id = @p4)));"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+}
+
+func TestQueryBuilder_CommitIsRepeatable(t *testing.T) {
+	query := "select * from accounts"
+	qb := NewQueryBuilder(query).Where(Or(ByIntColumn("id", []int{1}), ByIntColumn("id", []int{2})))
+
+	first, firstValues := qb.Commit()
+	second, secondValues := qb.Commit()
+
+	if first != second || len(firstValues) != len(secondValues) {
+		t.Errorf("Expected repeated commits to match\nFirst: %s %v\nSecond: %s %v", first, firstValues, second, secondValues)
+	}
+}
+
+func TestQueryBuilder_OrWhere(t *testing.T) {
+	query := "select * from accounts"
+	qb := NewQueryBuilder(query)
+	result, values := qb.
+		Where(ByIntColumn("id", []int{1})).
+		OrWhere(ByStringColumn("name", []string{"john"})).
+		Commit()
+
+	expected := "select * from accounts WHERE id = $1 OR name = $2;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+
+	if len(values) != 2 {
+		t.Errorf("Expected 2 values, got %d", len(values))
+	}
+}
+
+func TestQueryBuilder_MixedConnectors(t *testing.T) {
+	query := "select * from accounts"
+	qb := NewQueryBuilder(query)
+	result, _ := qb.
+		Where(ByIntColumn("id", []int{1})).
+		OrWhere(ByIntColumn("id", []int{2}), ByIntColumn("id", []int{3})).
+		AndWhere(Or(ByStringColumn("name", []string{"john"}), ByStringColumn("name", []string{"jane"}))).
+		Commit()
+
+	expected := "select * from accounts WHERE id = $1 OR id = $2 OR id = $3 AND (name = $4 OR name = $5);"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+}
+
+func TestQueryBuilder_OrWhereFirstCondition(t *testing.T) {
+	query := "select * from accounts"
+	qb := NewQueryBuilder(query)
+	result, _ := qb.
+		Where(ByIntColumn("id", nil)).
+		OrWhere(ByIntColumn("id", []int{2})).
+		Commit()
+
+	// The connector of the first rendered condition is dropped
+	expected := "select * from accounts WHERE id = $1;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+}
@@ -0,0 +1,95 @@
+package querybuilder_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/bolanosdev/query-builder"
+)
+
+func TestCountQuery_BaseQuery(t *testing.T) {
+	qb := NewQueryBuilder("select * from accounts").
+		Where(ByIntColumn("id", []int{1, 2}), ByStringColumn("name", []string{"car"}, StringContains)).
+		SortBy(Sort("name")).
+		Limit(10).
+		Offset(20)
+
+	query, values, err := qb.CountQuery()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := "SELECT COUNT(*) FROM (select * from accounts WHERE id IN ($1, $2) AND name LIKE '%' || $3 || '%' ESCAPE '!') AS count_query;"
+	if query != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, query)
+	}
+
+	_, pageValues := qb.Commit()
+	if len(values) != 3 || len(pageValues) != len(values) {
+		t.Errorf("Expected the page values %v, got %v", pageValues, values)
+	}
+}
+
+func TestCountQuery_StructuredSelect(t *testing.T) {
+	query, values, err := NewQueryBuilder("", WithDialect(SQLServer)).
+		Select("a.id", "a.name").
+		From("accounts AS a").
+		Join("teams AS t", On("t.id", "a.team_id"), ByBoolColumn("t.active", true)).
+		Where(ByIntColumn("a.id", []int{1})).
+		SortBy(Sort("a.name")).
+		Limit(10).
+		CountQuery()
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := "SELECT COUNT(*) FROM accounts AS a JOIN teams AS t ON t.id = a.team_id AND t.active = 1 WHERE a.id = @p1;"
+	if query != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, query)
+	}
+
+	if len(values) != 1 {
+		t.Errorf("Expected 1 value, got %v", values)
+	}
+}
+
+func TestCountQuery_GroupedSelectIsWrapped(t *testing.T) {
+	query, values, _ := Select("team_id", Count("*")+" AS members").
+		From("accounts").
+		Where(ByBoolColumn("active", true)).
+		GroupBy("team_id").
+		Having(ByIntComparison(Count("*"), OpGt, 5)).
+		SortBy(Sort("team_id")).
+		CountQuery()
+
+	expected := "SELECT COUNT(*) FROM (SELECT team_id, COUNT(*) AS members FROM accounts WHERE active = TRUE GROUP BY team_id HAVING COUNT(*) > $1) AS count_query;"
+	if query != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, query)
+	}
+
+	if len(values) != 1 || values[0] != 5 {
+		t.Errorf("Expected [5], got %v", values)
+	}
+}
+
+func TestCountQuery_DoesNotChangeBuild(t *testing.T) {
+	qb := NewQueryBuilder("select * from accounts").Where(ByIntColumn("id", []int{1})).Limit(5)
+
+	before, _ := qb.Commit()
+	if _, _, err := qb.CountQuery(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	after, _ := qb.Commit()
+
+	if before != after {
+		t.Errorf("Expected: %s\nGot: %s", before, after)
+	}
+}
+
+func TestCountQuery_Errors(t *testing.T) {
+	_, _, err := NewQueryBuilder("select * from accounts").Where(ByIntColumn("id;", []int{1})).CountQuery()
+	if !errors.Is(err, ErrInvalidColumnName) {
+		t.Errorf("Expected ErrInvalidColumnName, got %v", err)
+	}
+}
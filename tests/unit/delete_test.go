@@ -0,0 +1,137 @@
+package querybuilder_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/bolanosdev/query-builder"
+)
+
+func TestDelete_Where(t *testing.T) {
+	query, values, err := Delete("accounts").
+		Where(ByIntColumn("id", []int{1, 2})).
+		OrWhere(ByStringColumn("name", []string{"bot"}, StringStartsWith)).
+		Build()
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := "DELETE FROM accounts WHERE id IN ($1, $2) OR name LIKE $3 || '%' ESCAPE '!';"
+	if query != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, query)
+	}
+
+	if len(values) != 3 {
+		t.Errorf("Expected 3 values, got %d", len(values))
+	}
+}
+
+func TestDelete_Returning(t *testing.T) {
+	cases := map[Dialect]string{
+		Postgres:  "DELETE FROM accounts WHERE id = $1 RETURNING id, name;",
+		SQLite:    "DELETE FROM accounts WHERE id = ? RETURNING id, name;",
+		SQLServer: "DELETE FROM accounts OUTPUT DELETED.id, DELETED.name WHERE id = @p1;",
+	}
+
+	for dialect, expected := range cases {
+		query, _ := Delete("accounts", WithDialect(dialect)).
+			Where(ByIntColumn("id", []int{1})).
+			Returning("id", "name").
+			MustBuild()
+
+		if query != expected {
+			t.Errorf("Expected: %s\nGot: %s", expected, query)
+		}
+	}
+}
+
+func TestDelete_Limit(t *testing.T) {
+	cases := map[Dialect]string{
+		MySQL:     "DELETE FROM accounts WHERE active = FALSE LIMIT 100;",
+		SQLServer: "DELETE TOP (100) FROM accounts OUTPUT DELETED.id WHERE active = 0;",
+	}
+
+	for dialect, expected := range cases {
+		db := Delete("accounts", WithDialect(dialect)).
+			Where(ByBoolColumn("active", false)).
+			Limit(100)
+		if dialect == SQLServer {
+			db.Returning("id")
+		}
+
+		query, _ := db.MustBuild()
+		if query != expected {
+			t.Errorf("Expected: %s\nGot: %s", expected, query)
+		}
+	}
+}
+
+func TestDelete_LimitUnsupported(t *testing.T) {
+	for _, dialect := range []Dialect{Postgres, SQLite} {
+		_, _, err := Delete("accounts", WithDialect(dialect)).
+			Where(ByIntColumn("id", []int{1})).
+			Limit(10).
+			Build()
+
+		if !errors.Is(err, ErrUnsupportedByDialect) {
+			t.Errorf("Expected ErrUnsupportedByDialect, got %v", err)
+		}
+	}
+}
+
+func TestDelete_NegativeLimit(t *testing.T) {
+	_, _, err := Delete("accounts", WithDialect(MySQL)).
+		Where(ByBoolColumn("active", false)).
+		Limit(-3).
+		Build()
+
+	if !errors.Is(err, ErrInvalidLimit) {
+		t.Errorf("Expected ErrInvalidLimit, got %v", err)
+	}
+}
+
+func TestDelete_MissingWhere(t *testing.T) {
+	_, _, err := Delete("accounts").Build()
+	if !errors.Is(err, ErrMissingWhere) {
+		t.Errorf("Expected ErrMissingWhere, got %v", err)
+	}
+
+	// Empty conditions are optional filters and do not count as a WHERE clause
+	_, _, err = Delete("accounts").Where(ByStringColumn("name", []string{}), Or()).Build()
+	if !errors.Is(err, ErrMissingWhere) {
+		t.Errorf("Expected ErrMissingWhere for empty conditions, got %v", err)
+	}
+}
+
+func TestDelete_AllRows(t *testing.T) {
+	query, values, err := Delete("sessions", WithQuotedIdentifiers()).AllRows().Build()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := `DELETE FROM "sessions";`
+	if query != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, query)
+	}
+
+	if len(values) != 0 {
+		t.Errorf("Expected no values, got %v", values)
+	}
+}
+
+func TestDelete_InvalidIdentifiers(t *testing.T) {
+	builders := map[string]*DeleteBuilder{
+		"table":     Delete("accounts--").AllRows(),
+		"where":     Delete("accounts").Where(ByIntColumn("id or 1=1", []int{1})),
+		"returning": Delete("accounts").AllRows().Returning("*"),
+	}
+
+	for name, db := range builders {
+		t.Run(name, func(t *testing.T) {
+			if _, _, err := db.Build(); !errors.Is(err, ErrInvalidColumnName) {
+				t.Errorf("Expected ErrInvalidColumnName, got %v", err)
+			}
+		})
+	}
+}
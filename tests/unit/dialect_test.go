@@ -0,0 +1,147 @@
+package querybuilder_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/bolanosdev/query-builder"
+)
+
+func buildDialectQuery(opts ...Option) (string, []any) {
+	return NewQueryBuilder("select * from accounts", opts...).
+		Where(
+			ByIntColumn("id", []int{1, 2}),
+			ByStringColumn("name", []string{"car"}, StringContains),
+		).
+		SortBy(Sort("name")).
+		Limit(10).
+		Offset(5).
+		Commit()
+}
+
+func TestDialect_PostgresIsDefault(t *testing.T) {
+	result, values := buildDialectQuery()
+
+	expected := "select * from accounts WHERE id IN ($1, $2) AND name LIKE '%' || $3 || '%' ESCAPE '!' ORDER BY name LIMIT 10 OFFSET 5;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+
+	if len(values) != 3 {
+		t.Errorf("Expected 3 values, got %d", len(values))
+	}
+}
+
+func TestDialect_Postgres(t *testing.T) {
+	result, _ := buildDialectQuery(WithDialect(Postgres))
+
+	expected := "select * from accounts WHERE id IN ($1, $2) AND name LIKE '%' || $3 || '%' ESCAPE '!' ORDER BY name LIMIT 10 OFFSET 5;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+}
+
+func TestDialect_MySQL(t *testing.T) {
+	result, _ := buildDialectQuery(WithDialect(MySQL))
+
+	expected := "select * from accounts WHERE id IN (?, ?) AND name LIKE CONCAT('%', ?, '%') ESCAPE '!' ORDER BY name LIMIT 10 OFFSET 5;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+}
+
+func TestDialect_SQLite(t *testing.T) {
+	result, _ := buildDialectQuery(WithDialect(SQLite))
+
+	expected := "select * from accounts WHERE id IN (?, ?) AND name LIKE '%' || ? || '%' ESCAPE '!' ORDER BY name LIMIT 10 OFFSET 5;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+}
+
+func TestDialect_SQLServer(t *testing.T) {
+	result, _ := buildDialectQuery(WithDialect(SQLServer))
+
+	expected := "select * from accounts WHERE id IN (@p1, @p2) AND name LIKE '%' + @p3 + '%' ESCAPE '!' ORDER BY name OFFSET 5 ROWS FETCH NEXT 10 ROWS ONLY;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+}
+
+func TestDialect_SQLServerPaginationWithoutSort(t *testing.T) {
+	result, _ := NewQueryBuilder("select * from accounts", WithDialect(SQLServer)).Limit(10).Commit()
+
+	expected := "select * from accounts ORDER BY (SELECT NULL) OFFSET 0 ROWS FETCH NEXT 10 ROWS ONLY;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+}
+
+func TestDialect_PlaceholderOverride(t *testing.T) {
+	result, _ := NewQueryBuilder("select * from accounts", WithDialect(SQLite), WithPlaceholders(PlaceholderColon)).
+		Where(ByIntColumn("id", []int{1}), ByStringColumn("name", []string{"carlos"})).
+		Commit()
+
+	expected := "select * from accounts WHERE id = :1 AND name = :2;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+}
+
+func TestDialect_QuotedIdentifiers(t *testing.T) {
+	cases := map[Dialect]string{
+		Postgres:  `select * from accounts WHERE "accounts"."id" = $1 ORDER BY "name" DESC;`,
+		MySQL:     "select * from accounts WHERE `accounts`.`id` = ? ORDER BY `name` DESC;",
+		SQLite:    `select * from accounts WHERE "accounts"."id" = ? ORDER BY "name" DESC;`,
+		SQLServer: "select * from accounts WHERE [accounts].[id] = @p1 ORDER BY [name] DESC;",
+	}
+
+	for dialect, expected := range cases {
+		result, _ := NewQueryBuilder("select * from accounts", WithDialect(dialect), WithQuotedIdentifiers()).
+			Where(ByIntColumn("accounts.id", []int{1})).
+			SortBy(Sort("name", SortDesc)).
+			Commit()
+
+		if result != expected {
+			t.Errorf("Expected: %s\nGot: %s", expected, result)
+		}
+	}
+}
+
+func TestDialect_PlaceholderStyles(t *testing.T) {
+	cases := map[PlaceholderStyle]string{
+		PlaceholderDollar:   "$3",
+		PlaceholderQuestion: "?",
+		PlaceholderAtP:      "@p3",
+		PlaceholderColon:    ":3",
+	}
+
+	for style, expected := range cases {
+		if got := style.Format(3); got != expected {
+			t.Errorf("Expected: %s\nGot: %s", expected, got)
+		}
+	}
+}
+
+func TestDialect_DateExact(t *testing.T) {
+	date := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+	cases := map[Dialect]string{
+		Postgres:  "select * from events WHERE DATE(created_at) = DATE($1) AND DATE(updated_at) <> DATE($2);",
+		MySQL:     "select * from events WHERE DATE(created_at) = DATE(?) AND DATE(updated_at) <> DATE(?);",
+		SQLite:    "select * from events WHERE DATE(created_at) = DATE(?) AND DATE(updated_at) <> DATE(?);",
+		SQLServer: "select * from events WHERE CAST(created_at AS DATE) = CAST(@p1 AS DATE) AND CAST(updated_at AS DATE) <> CAST(@p2 AS DATE);",
+	}
+
+	for dialect, expected := range cases {
+		result, _ := NewQueryBuilder("select * from events", WithDialect(dialect)).
+			Where(
+				ByDateColumn("created_at", Dates{On: date}),
+				Not(ByDateColumn("updated_at", Dates{On: date})),
+			).
+			Commit()
+
+		if result != expected {
+			t.Errorf("Expected: %s\nGot: %s", expected, result)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package querybuilder_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/bolanosdev/query-builder"
+)
+
+type accountID int64
+
+type uuid [16]byte
+
+func TestQueryBuilder_ByColumnInt64(t *testing.T) {
+	query := "select * from accounts"
+	qb := NewQueryBuilder(query)
+	result, values := qb.Where(ByColumn("id", []int64{1, 2, 3})).Commit()
+
+	expected := "select * from accounts WHERE id IN ($1, $2, $3);"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+
+	if len(values) != 3 || values[0] != int64(1) {
+		t.Errorf("Expected [1 2 3] as int64, got %v", values)
+	}
+}
+
+func TestQueryBuilder_ByColumnSingleValue(t *testing.T) {
+	query := "select * from accounts"
+	qb := NewQueryBuilder(query)
+	result, values := qb.Where(ByColumn("tenant_id", []int32{7})).Commit()
+
+	expected := "select * from accounts WHERE tenant_id = $1;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+
+	if len(values) != 1 || values[0] != int32(7) {
+		t.Errorf("Expected [7] as int32, got %v", values)
+	}
+}
+
+func TestQueryBuilder_ByColumnCustomTypes(t *testing.T) {
+	first := uuid{1}
+	second := uuid{2}
+
+	query := "select * from accounts"
+	qb := NewQueryBuilder(query)
+	result, values := qb.Where(
+		ByColumn("id", []accountID{10, 20}),
+		Not(ByColumn("external_id", []uuid{first, second})),
+	).Commit()
+
+	expected := "select * from accounts WHERE id IN ($1, $2) AND external_id NOT IN ($3, $4);"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+
+	if len(values) != 4 || values[0] != accountID(10) || values[2] != first {
+		t.Errorf("Expected values of their original types, got %v", values)
+	}
+}
+
+func TestQueryBuilder_ByColumnSliceElements(t *testing.T) {
+	query := "select * from files"
+	qb := NewQueryBuilder(query)
+	result, values := qb.Where(ByColumn("checksum", [][]byte{{0xde, 0xad}, {0xbe, 0xef}})).Commit()
+
+	// Slice elements are bound whole rather than flattened
+	expected := "select * from files WHERE checksum IN ($1, $2);"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+
+	if len(values) != 2 {
+		t.Errorf("Expected 2 values, got %d", len(values))
+	}
+}
+
+func TestQueryBuilder_ByColumnAnySliceElement(t *testing.T) {
+	qb := NewQueryBuilder("select * from events")
+	result, values := qb.Where(ByColumn("payload", [][]any{{1, "a"}})).Commit()
+
+	// A single []any value is one argument, not a list of them
+	expected := "select * from events WHERE payload = $1;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+
+	if len(values) != 1 {
+		t.Errorf("Expected 1 value, got %d: %v", len(values), values)
+	}
+}
+
+func TestQueryBuilder_ByColumnEmptyAndInvalid(t *testing.T) {
+	result, _ := NewQueryBuilder("select * from accounts").Where(ByColumn[int64]("id", nil)).Commit()
+
+	expected := "select * from accounts;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+
+	if err := ByColumn("id;", []int64{1}).Err(); !errors.Is(err, ErrInvalidColumnName) {
+		t.Errorf("Expected ErrInvalidColumnName, got %v", err)
+	}
+}
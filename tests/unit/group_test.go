@@ -0,0 +1,105 @@
+package querybuilder_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/bolanosdev/query-builder"
+)
+
+func TestGroup_GroupByHaving(t *testing.T) {
+	result, values := Select("team_id", Count("*")+" AS members", Avg("score")+" AS avg_score").
+		From("accounts").
+		Where(ByBoolColumn("active", true), ByStringColumn("region", []string{"eu", "us"})).
+		GroupBy("team_id").
+		Having(ByIntComparison(Count("*"), OpGt, 5), ByFloatRange(Avg("score"), Range[float64]{Min: ptrTo(1.5)})).
+		SortBy(Sort(Count("*"), SortDesc)).
+		Limit(10).
+		Commit()
+
+	expected := "SELECT team_id, COUNT(*) AS members, AVG(score) AS avg_score FROM accounts" +
+		" WHERE active = TRUE AND region IN ($1, $2)" +
+		" GROUP BY team_id" +
+		" HAVING COUNT(*) > $3 AND AVG(score) >= $4" +
+		" ORDER BY COUNT(*) DESC LIMIT 10;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+
+	if len(values) != 4 || values[2] != 5 || values[3] != 1.5 {
+		t.Errorf("Expected [eu us 5 1.5], got %v", values)
+	}
+}
+
+func TestGroup_WithBaseQueryAndGroups(t *testing.T) {
+	result, _ := NewQueryBuilder("select owner_id, sum(amount) from invoices").
+		GroupBy("owner_id").
+		Having(Or(ByIntComparison(Sum("amount"), OpGte, 1000), ByIntComparison(Max("amount"), OpGt, 500)), Not(IsNull(Min("paid_at")))).
+		Commit()
+
+	expected := "select owner_id, sum(amount) from invoices GROUP BY owner_id HAVING (SUM(amount) >= $1 OR MAX(amount) > $2) AND MIN(paid_at) IS NOT NULL;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+}
+
+func TestGroup_QuotedIdentifiers(t *testing.T) {
+	result, _ := NewQueryBuilder("", WithDialect(MySQL), WithQuotedIdentifiers()).
+		Select("t.name", Count("a.id")+" AS total").
+		From("accounts AS a").
+		Join("teams AS t", On("t.id", "a.team_id")).
+		GroupBy("t.name").
+		Having(ByIntComparison(Count("a.id"), OpGt, 1)).
+		Commit()
+
+	expected := "SELECT `t`.`name`, COUNT(`a`.`id`) AS `total` FROM `accounts` AS `a` JOIN `teams` AS `t` ON `t`.`id` = `a`.`team_id` GROUP BY `t`.`name` HAVING COUNT(`a`.`id`) > ?;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+}
+
+func TestGroup_Errors(t *testing.T) {
+	cases := map[string]*QueryBuilder{
+		"group by":           NewQueryBuilder("select * from accounts").GroupBy("team_id;"),
+		"group by aggregate": NewQueryBuilder("select * from accounts").GroupBy(Count("id")),
+		"aggregate column":   NewQueryBuilder("select * from accounts").Having(ByIntComparison(Sum("amount) OR (1"), OpGt, 1)),
+		"sum of star":        NewQueryBuilder("select * from accounts").Having(ByIntComparison(Sum("*"), OpGt, 1)),
+		"unknown function":   NewQueryBuilder("select * from accounts").Having(ByIntComparison("LENGTH(name)", OpGt, 1)),
+	}
+
+	for name, qb := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, _, err := qb.Build(); !errors.Is(err, ErrInvalidColumnName) {
+				t.Errorf("Expected ErrInvalidColumnName, got %v", err)
+			}
+		})
+	}
+}
+
+func TestGroup_AggregatesOnlyInHaving(t *testing.T) {
+	cases := map[string]*QueryBuilder{
+		"where":    NewQueryBuilder("select * from accounts").Where(ByIntComparison(Count("*"), OpGt, 5)),
+		"or where": NewQueryBuilder("select * from accounts").OrWhere(ByIntComparison(Max("amount"), OpLt, 5)),
+		"group":    NewQueryBuilder("select * from accounts").Where(Or(ByIntColumn("id", []int{1}), Not(ByIntComparison(Sum("amount"), OpGt, 5)))),
+		"join":     Select().From("accounts AS a").Join("users AS u", On("u.id", "a.user_id"), ByIntComparison(Count("u.id"), OpGt, 1)),
+	}
+
+	for name, qb := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, _, err := qb.Build(); !errors.Is(err, ErrInvalidStatement) {
+				t.Errorf("Expected ErrInvalidStatement, got %v", err)
+			}
+		})
+	}
+
+	// LOWER is not an aggregate and stays valid in WHERE
+	query, _ := NewQueryBuilder("select * from accounts").Where(ByStringColumn(Lower("name"), []string{"ana"})).MustBuild()
+	expected := "select * from accounts WHERE LOWER(name) = $1;"
+	if query != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, query)
+	}
+}
+
+func ptrTo[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,211 @@
+package querybuilder_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/bolanosdev/query-builder"
+)
+
+func TestInsert_SingleRow(t *testing.T) {
+	query, values, err := Insert("accounts").
+		Columns("id", "name").
+		Values(1, "carlos").
+		Build()
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := "INSERT INTO accounts (id, name) VALUES ($1, $2);"
+	if query != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, query)
+	}
+
+	if len(values) != 2 || values[0] != 1 || values[1] != "carlos" {
+		t.Errorf("Expected [1 carlos], got %v", values)
+	}
+}
+
+func TestInsert_MultipleRows(t *testing.T) {
+	query, values := Insert("accounts").
+		Columns("id", "name").
+		Values(1, "carlos").
+		Values(2, "john").
+		MustBuild()
+
+	expected := "INSERT INTO accounts (id, name) VALUES ($1, $2), ($3, $4);"
+	if query != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, query)
+	}
+
+	if len(values) != 4 {
+		t.Errorf("Expected 4 values, got %d", len(values))
+	}
+}
+
+func TestInsert_Returning(t *testing.T) {
+	cases := map[Dialect]string{
+		Postgres:  "INSERT INTO accounts (name) VALUES ($1) RETURNING id, created_at;",
+		SQLite:    "INSERT INTO accounts (name) VALUES (?) RETURNING id, created_at;",
+		SQLServer: "INSERT INTO accounts (name) OUTPUT INSERTED.id, INSERTED.created_at VALUES (@p1);",
+	}
+
+	for dialect, expected := range cases {
+		query, _ := Insert("accounts", WithDialect(dialect)).
+			Columns("name").
+			Values("carlos").
+			Returning("id", "created_at").
+			MustBuild()
+
+		if query != expected {
+			t.Errorf("Expected: %s\nGot: %s", expected, query)
+		}
+	}
+}
+
+func TestInsert_ReturningUnsupportedByMySQL(t *testing.T) {
+	_, _, err := Insert("accounts", WithDialect(MySQL)).
+		Columns("name").
+		Values("carlos").
+		Returning("id").
+		Build()
+
+	if !errors.Is(err, ErrUnsupportedByDialect) {
+		t.Errorf("Expected ErrUnsupportedByDialect, got %v", err)
+	}
+}
+
+func TestInsert_QuotedIdentifiers(t *testing.T) {
+	query, _ := Insert("public.accounts", WithDialect(MySQL), WithQuotedIdentifiers()).
+		Columns("id", "name").
+		Values(1, "carlos").
+		MustBuild()
+
+	expected := "INSERT INTO `public`.`accounts` (`id`, `name`) VALUES (?, ?);"
+	if query != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, query)
+	}
+}
+
+func TestInsert_InvalidIdentifiers(t *testing.T) {
+	builders := map[string]*InsertBuilder{
+		"table":     Insert("accounts; DROP TABLE accounts").Columns("id").Values(1),
+		"column":    Insert("accounts").Columns("id--").Values(1),
+		"returning": Insert("accounts").Columns("id").Values(1).Returning("id)"),
+	}
+
+	for name, ib := range builders {
+		t.Run(name, func(t *testing.T) {
+			if _, _, err := ib.Build(); !errors.Is(err, ErrInvalidColumnName) {
+				t.Errorf("Expected ErrInvalidColumnName, got %v", err)
+			}
+		})
+	}
+}
+
+func TestInsert_ValueCountMismatch(t *testing.T) {
+	_, _, err := Insert("accounts").
+		Columns("id", "name").
+		Values(1, "carlos").
+		Values(2).
+		Build()
+
+	if !errors.Is(err, ErrArgumentCount) {
+		t.Errorf("Expected ErrArgumentCount, got %v", err)
+	}
+}
+
+func TestInsert_MissingColumnsOrRows(t *testing.T) {
+	if _, _, err := Insert("accounts").Values(1).Build(); !errors.Is(err, ErrInvalidStatement) {
+		t.Errorf("Expected ErrInvalidStatement without columns, got %v", err)
+	}
+
+	if _, _, err := Insert("accounts").Columns("id").Build(); !errors.Is(err, ErrInvalidStatement) {
+		t.Errorf("Expected ErrInvalidStatement without rows, got %v", err)
+	}
+}
+
+func TestInsert_BuildExceedsParameterLimit(t *testing.T) {
+	_, _, err := Insert("accounts", WithMaxParams(4)).
+		Columns("id", "name").
+		Values(1, "carlos").
+		Values(2, "john").
+		Values(3, "jane").
+		Build()
+
+	if !errors.Is(err, ErrTooManyParameters) {
+		t.Errorf("Expected ErrTooManyParameters, got %v", err)
+	}
+}
+
+func TestInsert_BuildBatches(t *testing.T) {
+	batches, err := Insert("accounts", WithMaxParams(5)).
+		Columns("id", "name").
+		Values(1, "carlos").
+		Values(2, "john").
+		Values(3, "jane").
+		Returning("id").
+		BuildBatches()
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []Batch{
+		{Query: "INSERT INTO accounts (id, name) VALUES ($1, $2), ($3, $4) RETURNING id;", Values: []any{1, "carlos", 2, "john"}},
+		{Query: "INSERT INTO accounts (id, name) VALUES ($1, $2) RETURNING id;", Values: []any{3, "jane"}},
+	}
+
+	if len(batches) != len(expected) {
+		t.Fatalf("Expected %d batches, got %d", len(expected), len(batches))
+	}
+
+	for i, batch := range batches {
+		if batch.Query != expected[i].Query {
+			t.Errorf("Expected: %s\nGot: %s", expected[i].Query, batch.Query)
+		}
+
+		if len(batch.Values) != len(expected[i].Values) {
+			t.Fatalf("Expected values %v, got %v", expected[i].Values, batch.Values)
+		}
+		for j := range batch.Values {
+			if batch.Values[j] != expected[i].Values[j] {
+				t.Errorf("Expected values %v, got %v", expected[i].Values, batch.Values)
+			}
+		}
+	}
+}
+
+func TestInsert_BuildBatchesRowLimit(t *testing.T) {
+	ib := Insert("accounts", WithDialect(SQLServer)).Columns("id")
+	for i := 0; i < 2500; i++ {
+		ib.Values(i)
+	}
+
+	batches, err := ib.BuildBatches()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// SQL Server allows at most 1000 rows per VALUES list
+	sizes := []int{}
+	for _, batch := range batches {
+		sizes = append(sizes, len(batch.Values))
+	}
+
+	if len(sizes) != 3 || sizes[0] != 1000 || sizes[1] != 1000 || sizes[2] != 500 {
+		t.Errorf("Expected batches of [1000 1000 500], got %v", sizes)
+	}
+}
+
+func TestInsert_TooManyColumnsForParameterLimit(t *testing.T) {
+	_, err := Insert("accounts", WithMaxParams(1)).
+		Columns("id", "name").
+		Values(1, "carlos").
+		BuildBatches()
+
+	if !errors.Is(err, ErrTooManyParameters) {
+		t.Errorf("Expected ErrTooManyParameters, got %v", err)
+	}
+}
@@ -1,6 +1,7 @@
 package querybuilder_test
 
 import (
+	"errors"
 	"testing"
 
 	. "github.com/bolanosdev/query-builder"
@@ -51,3 +52,92 @@ func TestQueryBuilder_IntSliceInClause(t *testing.T) {
 		t.Errorf("Expected 3 values, got %d", len(values))
 	}
 }
+
+func TestQueryBuilder_IntComparison(t *testing.T) {
+	cases := map[ComparisonOperator]string{
+		OpEq:    "select * from accounts WHERE age = $1;",
+		OpNotEq: "select * from accounts WHERE age <> $1;",
+		OpGt:    "select * from accounts WHERE age > $1;",
+		OpGte:   "select * from accounts WHERE age >= $1;",
+		OpLt:    "select * from accounts WHERE age < $1;",
+		OpLte:   "select * from accounts WHERE age <= $1;",
+	}
+
+	for op, expected := range cases {
+		result, values := NewQueryBuilder("select * from accounts").Where(ByIntComparison("age", op, 18)).Commit()
+		if result != expected {
+			t.Errorf("Expected: %s\nGot: %s", expected, result)
+		}
+
+		if len(values) != 1 || values[0] != 18 {
+			t.Errorf("Expected [18], got %v", values)
+		}
+	}
+}
+
+func TestQueryBuilder_IntComparisonNegated(t *testing.T) {
+	query := "select * from accounts"
+	qb := NewQueryBuilder(query)
+	result, _ := qb.Where(Not(ByIntComparison("age", OpGt, 18))).Commit()
+
+	expected := "select * from accounts WHERE age <= $1;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+}
+
+func TestQueryBuilder_IntComparisonUnsupportedOperator(t *testing.T) {
+	_, _, err := NewQueryBuilder("select * from accounts").Where(ByIntComparison("age", ComparisonOperator(42), 18)).Build()
+	if !errors.Is(err, ErrUnsupportedValue) {
+		t.Errorf("Expected ErrUnsupportedValue, got %v", err)
+	}
+}
+
+func TestQueryBuilder_IntRange(t *testing.T) {
+	low, high := 18, 65
+
+	cases := []struct {
+		r        Range[int]
+		expected string
+		values   int
+	}{
+		{Range[int]{Min: &low, Max: &high}, "select * from accounts WHERE age >= $1 AND age <= $2;", 2},
+		{Range[int]{Min: &low, Max: &high, MinExclusive: true, MaxExclusive: true}, "select * from accounts WHERE age > $1 AND age < $2;", 2},
+		{Range[int]{Min: &low, Max: &high, MaxExclusive: true}, "select * from accounts WHERE age >= $1 AND age < $2;", 2},
+		{Range[int]{Min: &low}, "select * from accounts WHERE age >= $1;", 1},
+		{Range[int]{Min: &low, MinExclusive: true}, "select * from accounts WHERE age > $1;", 1},
+		{Range[int]{Max: &high}, "select * from accounts WHERE age <= $1;", 1},
+		{Range[int]{Max: &high, MaxExclusive: true}, "select * from accounts WHERE age < $1;", 1},
+		{Range[int]{}, "select * from accounts;", 0},
+	}
+
+	for _, c := range cases {
+		result, values := NewQueryBuilder("select * from accounts").Where(ByIntRange("age", c.r)).Commit()
+		if result != c.expected {
+			t.Errorf("Expected: %s\nGot: %s", c.expected, result)
+		}
+
+		if len(values) != c.values {
+			t.Errorf("Expected %d values, got %d", c.values, len(values))
+		}
+	}
+}
+
+func TestQueryBuilder_IntRangeInsideGroup(t *testing.T) {
+	low, high := 10, 20
+	query := "select * from orders"
+	qb := NewQueryBuilder(query)
+	result, values := qb.Where(Or(
+		ByIntRange("quantity", Range[int]{Min: &low, Max: &high}),
+		ByIntComparison("quantity", OpGt, 100),
+	)).Commit()
+
+	expected := "select * from orders WHERE (quantity >= $1 AND quantity <= $2 OR quantity > $3);"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+
+	if len(values) != 3 || values[0] != 10 || values[1] != 20 || values[2] != 100 {
+		t.Errorf("Expected [10 20 100], got %v", values)
+	}
+}
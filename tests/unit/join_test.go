@@ -0,0 +1,99 @@
+package querybuilder_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/bolanosdev/query-builder"
+)
+
+func TestJoin_Kinds(t *testing.T) {
+	result, _ := Select("a.id", "u.name", "t.name AS team", "r.name AS role", "p.title").
+		From("accounts AS a").
+		Join("users AS u", On("u.id", "a.user_id")).
+		LeftJoin("teams AS t", On("t.id", "u.team_id")).
+		RightJoin("roles AS r", On("r.id", "u.role_id")).
+		FullJoin("posts AS p", On("p.author_id", "u.id")).
+		Commit()
+
+	expected := "SELECT a.id, u.name, t.name AS team, r.name AS role, p.title FROM accounts AS a" +
+		" JOIN users AS u ON u.id = a.user_id" +
+		" LEFT JOIN teams AS t ON t.id = u.team_id" +
+		" RIGHT JOIN roles AS r ON r.id = u.role_id" +
+		" FULL JOIN posts AS p ON p.author_id = u.id;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+}
+
+func TestJoin_ConditionArgumentsNumberedBeforeWhere(t *testing.T) {
+	result, values := Select("a.id").
+		From("accounts AS a").
+		Join("users AS u", On("u.id", "a.user_id"), ByBoolColumn("u.active", true), ByStringColumn("u.region", []string{"eu", "us"})).
+		LeftJoin("posts AS p", On("p.author_id", "u.id"), ByIntComparison("p.score", OpGt, 10)).
+		Where(ByIntColumn("a.id", []int{7})).
+		OrWhere(IsNull("p.id")).
+		Commit()
+
+	expected := "SELECT a.id FROM accounts AS a" +
+		" JOIN users AS u ON u.id = a.user_id AND u.active = TRUE AND u.region IN ($1, $2)" +
+		" LEFT JOIN posts AS p ON p.author_id = u.id AND p.score > $3" +
+		" WHERE a.id = $4 OR p.id IS NULL;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+
+	if len(values) != 4 || values[0] != "eu" || values[2] != 10 || values[3] != 7 {
+		t.Errorf("Expected [eu us 10 7], got %v", values)
+	}
+}
+
+func TestJoin_OnComparisonAndNegation(t *testing.T) {
+	result, _ := Select().
+		From("orders AS o").
+		Join("orders AS later", OnComparison("later.created_at", OpGt, "o.created_at"), Not(On("later.id", "o.id"))).
+		Commit()
+
+	expected := "SELECT * FROM orders AS o JOIN orders AS later ON later.created_at > o.created_at AND later.id <> o.id;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+}
+
+func TestJoin_QuotedIdentifiersAndDialect(t *testing.T) {
+	result, _ := NewQueryBuilder("", WithDialect(SQLServer), WithQuotedIdentifiers()).
+		Select("a.id").
+		From("accounts AS a").
+		Join("users AS u", On("u.id", "a.user_id"), ByIntColumn("u.team_id", []int{3})).
+		Where(ByIntColumn("a.id", []int{1})).
+		Commit()
+
+	expected := "SELECT [a].[id] FROM [accounts] AS [a] JOIN [users] AS [u] ON [u].[id] = [a].[user_id] AND [u].[team_id] = @p1 WHERE [a].[id] = @p2;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+}
+
+func TestJoin_Errors(t *testing.T) {
+	cases := map[string]struct {
+		builder  *QueryBuilder
+		expected error
+	}{
+		"table":        {Select().From("accounts").Join("users;", On("a", "b")), ErrInvalidColumnName},
+		"left column":  {Select().From("accounts").Join("users", On("users.id)", "accounts.user_id")), ErrInvalidColumnName},
+		"right column": {Select().From("accounts").Join("users", On("users.id", "1 OR 1=1")), ErrInvalidColumnName},
+		"operator":     {Select().From("accounts").Join("users", OnComparison("a", ComparisonOperator(42), "b")), ErrUnsupportedValue},
+		"no condition": {Select().From("accounts").Join("users"), ErrInvalidStatement},
+		"empty only":   {Select().From("accounts").Join("users", ByIntColumn("users.id", []int{})), ErrInvalidStatement},
+		"missing From": {NewQueryBuilder("select * from accounts").Join("users", On("users.id", "accounts.user_id")), ErrInvalidStatement},
+		"mysql full":   {NewQueryBuilder("", WithDialect(MySQL)).Select().From("accounts").FullJoin("users", On("users.id", "accounts.user_id")), ErrUnsupportedByDialect},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, _, err := c.builder.Build(); !errors.Is(err, c.expected) {
+				t.Errorf("Expected %v, got %v", c.expected, err)
+			}
+		})
+	}
+}
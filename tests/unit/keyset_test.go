@@ -0,0 +1,207 @@
+package querybuilder_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/bolanosdev/query-builder"
+)
+
+func TestKeyset_SingleField(t *testing.T) {
+	result, values := NewQueryBuilder("select * from accounts").
+		SortBy(Sort("id")).
+		After(42).
+		Limit(20).
+		Commit()
+
+	expected := "select * from accounts WHERE id > $1 ORDER BY id LIMIT 20;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+
+	if len(values) != 1 || values[0] != 42 {
+		t.Errorf("Expected [42], got %v", values)
+	}
+}
+
+func TestKeyset_RowValue(t *testing.T) {
+	cases := map[SortDirection]string{
+		SortAsc:  "select * from accounts WHERE active = TRUE AND (created_at, id) > ($1, $2) ORDER BY created_at, id LIMIT 20;",
+		SortDesc: "select * from accounts WHERE active = TRUE AND (created_at, id) < ($1, $2) ORDER BY created_at DESC, id DESC LIMIT 20;",
+	}
+
+	for direction, expected := range cases {
+		result, _ := NewQueryBuilder("select * from accounts").
+			Where(ByBoolColumn("active", true)).
+			SortBy(Sort("created_at", direction), Sort("id", direction)).
+			After("2024-01-01", 7).
+			Limit(20).
+			Commit()
+
+		if result != expected {
+			t.Errorf("Expected: %s\nGot: %s", expected, result)
+		}
+	}
+}
+
+func TestKeyset_MixedDirections(t *testing.T) {
+	result, values := NewQueryBuilder("select * from accounts").
+		Where(ByIntColumn("team_id", []int{3})).
+		SortBy(Sort("score", SortDesc), Sort("name"), Sort("id")).
+		After(90, "carlos", 7).
+		Commit()
+
+	expected := "select * from accounts WHERE team_id = $1 AND (score < $2 OR (score = $3 AND name > $4) OR (score = $5 AND name = $6 AND id > $7)) ORDER BY score DESC, name, id;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+
+	if len(values) != 7 || values[1] != 90 || values[5] != "carlos" || values[6] != 7 {
+		t.Errorf("Expected [3 90 90 carlos 90 carlos 7], got %v", values)
+	}
+}
+
+func TestKeyset_SQLServerExpandsRowValues(t *testing.T) {
+	result, _ := NewQueryBuilder("select * from accounts", WithDialect(SQLServer)).
+		SortBy(Sort("name"), Sort("id")).
+		After("carlos", 1).
+		Limit(10).
+		Commit()
+
+	expected := "select * from accounts WHERE (name > @p1 OR (name = @p2 AND id > @p3)) ORDER BY name, id OFFSET 0 ROWS FETCH NEXT 10 ROWS ONLY;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+}
+
+func TestKeyset_OrConditionsAreGrouped(t *testing.T) {
+	result, _ := NewQueryBuilder("select * from accounts").
+		Where(ByIntColumn("team_id", []int{1})).
+		OrWhere(ByIntColumn("owner_id", []int{2})).
+		SortBy(Sort("id")).
+		After(10).
+		Commit()
+
+	expected := "select * from accounts WHERE (team_id = $1 OR owner_id = $2) AND id > $3 ORDER BY id;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+}
+
+func TestKeyset_Cursor(t *testing.T) {
+	qb := NewQueryBuilder("select * from accounts").SortBy(Sort("a.name"), Sort("a.id", SortDesc))
+
+	cursor, err := qb.NextCursor(map[string]any{"name": "carlos", "id": 7, "email": "c@example.com"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	result, values := qb.AfterCursor(cursor).Limit(5).Commit()
+
+	expected := "select * from accounts WHERE (a.name > $1 OR (a.name = $2 AND a.id < $3)) ORDER BY a.name, a.id DESC LIMIT 5;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+
+	if len(values) != 3 || values[0] != "carlos" || values[2] != int64(7) {
+		t.Errorf("Expected [carlos carlos 7], got %v", values)
+	}
+}
+
+func TestKeyset_EncodeDecodeCursor(t *testing.T) {
+	cursor, err := EncodeCursor("carlos", 7, 1.5, true)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	values, err := DecodeCursor(cursor)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []any{"carlos", int64(7), 1.5, true}
+	if len(values) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, values)
+	}
+	for i := range expected {
+		if values[i] != expected[i] {
+			t.Errorf("Expected %v, got %v", expected, values)
+		}
+	}
+}
+
+func TestKeyset_NullCursorValues(t *testing.T) {
+	qb := NewQueryBuilder("select * from posts").SortBy(Sort("published_at", SortDesc), Sort("id", SortDesc))
+	if _, err := qb.NextCursor(map[string]any{"published_at": nil, "id": 7}); !errors.Is(err, ErrInvalidCursor) {
+		t.Errorf("NextCursor: Expected ErrInvalidCursor, got %v", err)
+	}
+
+	if _, err := EncodeCursor(nil, 7); !errors.Is(err, ErrInvalidCursor) {
+		t.Errorf("EncodeCursor: Expected ErrInvalidCursor, got %v", err)
+	}
+
+	// [null] and [null,7], as encoded before nil values were rejected
+	for _, cursor := range []string{"W251bGxd", "W251bGwsN10"} {
+		if _, err := DecodeCursor(cursor); !errors.Is(err, ErrInvalidCursor) {
+			t.Errorf("DecodeCursor(%s): Expected ErrInvalidCursor, got %v", cursor, err)
+		}
+
+		_, _, err := NewQueryBuilder("select * from posts").SortBy(Sort("id")).AfterCursor(cursor).Build()
+		if !errors.Is(err, ErrInvalidCursor) {
+			t.Errorf("AfterCursor(%s): Expected ErrInvalidCursor, got %v", cursor, err)
+		}
+	}
+
+	_, _, err := NewQueryBuilder("select * from posts").SortBy(Sort("id")).After(nil).Build()
+	if !errors.Is(err, ErrInvalidCursor) {
+		t.Errorf("After: Expected ErrInvalidCursor, got %v", err)
+	}
+}
+
+func TestKeyset_EmptyCursorIsFirstPage(t *testing.T) {
+	result, _ := NewQueryBuilder("select * from accounts").SortBy(Sort("id")).AfterCursor("").Limit(5).Commit()
+
+	expected := "select * from accounts ORDER BY id LIMIT 5;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+}
+
+func TestKeyset_CountQueryIgnoresCursor(t *testing.T) {
+	query, values, _ := NewQueryBuilder("select * from accounts").
+		Where(ByIntColumn("team_id", []int{3})).
+		SortBy(Sort("id")).
+		After(10).
+		CountQuery()
+
+	expected := "SELECT COUNT(*) FROM (select * from accounts WHERE team_id = $1) AS count_query;"
+	if query != expected || len(values) != 1 {
+		t.Errorf("Expected: %s\nGot: %s %v", expected, query, values)
+	}
+}
+
+func TestKeyset_Errors(t *testing.T) {
+	cases := map[string]struct {
+		builder  *QueryBuilder
+		expected error
+	}{
+		"not base64":      {NewQueryBuilder("select * from accounts").SortBy(Sort("id")).AfterCursor("%%%"), ErrInvalidCursor},
+		"not a JSON list": {NewQueryBuilder("select * from accounts").SortBy(Sort("id")).AfterCursor("e30"), ErrInvalidCursor},
+		"value count":     {NewQueryBuilder("select * from accounts").SortBy(Sort("id")).After(1, 2), ErrArgumentCount},
+		"no sort fields":  {NewQueryBuilder("select * from accounts").After(1), ErrInvalidStatement},
+		"with offset":     {NewQueryBuilder("select * from accounts").SortBy(Sort("id")).After(1).Offset(20), ErrInvalidStatement},
+		"with page":       {NewQueryBuilder("select * from accounts").SortBy(Sort("id")).Paginate(3, 10).After(1), ErrInvalidStatement},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, _, err := c.builder.Build(); !errors.Is(err, c.expected) {
+				t.Errorf("Expected %v, got %v", c.expected, err)
+			}
+		})
+	}
+
+	if _, err := NewQueryBuilder("select * from accounts").SortBy(Sort("id")).NextCursor(map[string]any{"name": "x"}); !errors.Is(err, ErrInvalidCursor) {
+		t.Errorf("Expected ErrInvalidCursor for a row without sort fields, got %v", err)
+	}
+}
@@ -0,0 +1,107 @@
+package querybuilder_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/bolanosdev/query-builder"
+)
+
+func TestLimitPolicy_DefaultLimit(t *testing.T) {
+	cases := map[string]*QueryBuilder{
+		"select * from accounts LIMIT 25;":           NewQueryBuilder("select * from accounts", WithDefaultLimit(25)),
+		"select * from accounts LIMIT 25 OFFSET 50;": NewQueryBuilder("select * from accounts", WithDefaultLimit(25)).Offset(50),
+		"select * from accounts LIMIT 5;":            NewQueryBuilder("select * from accounts", WithDefaultLimit(25)).Limit(5),
+		"select * from accounts LIMIT 25 OFFSET 25;": NewQueryBuilder("select * from accounts", WithDefaultLimit(25)).Paginate(2, 0),
+	}
+
+	for expected, qb := range cases {
+		if result, _ := qb.Commit(); result != expected {
+			t.Errorf("Expected: %s\nGot: %s", expected, result)
+		}
+	}
+}
+
+func TestLimitPolicy_MaxLimitClamps(t *testing.T) {
+	cases := []struct {
+		builder  *QueryBuilder
+		expected string
+	}{
+		{NewQueryBuilder("select * from accounts", WithMaxLimit(500)).Limit(10000), "select * from accounts LIMIT 500;"},
+		{NewQueryBuilder("select * from accounts", WithMaxLimit(500)).Limit(200), "select * from accounts LIMIT 200;"},
+		{NewQueryBuilder("select * from accounts", WithMaxLimit(500)), "select * from accounts LIMIT 500;"},
+		{NewQueryBuilder("select * from accounts", WithMaxLimit(500)).Offset(20), "select * from accounts LIMIT 10 OFFSET 20;"},
+		{NewQueryBuilder("select * from accounts", WithMaxLimit(50), WithDefaultLimit(100)), "select * from accounts LIMIT 50;"},
+	}
+
+	for _, c := range cases {
+		if result, _ := c.builder.Commit(); result != c.expected {
+			t.Errorf("Expected: %s\nGot: %s", c.expected, result)
+		}
+	}
+}
+
+func TestLimitPolicy_MaxLimitError(t *testing.T) {
+	_, _, err := NewQueryBuilder("select * from accounts", WithMaxLimit(500, LimitError)).Limit(501).Build()
+	if !errors.Is(err, ErrInvalidLimit) {
+		t.Errorf("Expected ErrInvalidLimit, got %v", err)
+	}
+
+	// Defaults are clamped even with LimitError
+	result, _, err := NewQueryBuilder("select * from accounts", WithMaxLimit(5, LimitError)).Offset(10).Build()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := "select * from accounts LIMIT 5 OFFSET 10;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+}
+
+func TestLimitPolicy_MaxLimitCapsPaginate(t *testing.T) {
+	for _, policy := range []LimitPolicy{LimitClamp, LimitError} {
+		qb := NewQueryBuilder("select * from accounts", WithMaxLimit(50, policy)).Paginate(2, 100)
+
+		query, _, err := qb.Build()
+		if err != nil {
+			t.Fatalf("policy %d: unexpected error %v", policy, err)
+		}
+
+		expected := "select * from accounts LIMIT 50 OFFSET 50;"
+		if query != expected {
+			t.Errorf("policy %d: Expected: %s\nGot: %s", policy, expected, query)
+		}
+
+		if info := qb.PageInfo(500); info.Page != 2 || info.PageSize != 50 {
+			t.Errorf("policy %d: Expected page 2 of size 50, got %+v", policy, info)
+		}
+	}
+}
+
+func TestLimitPolicy_NegativeValues(t *testing.T) {
+	for _, qb := range []*QueryBuilder{
+		NewQueryBuilder("select * from accounts").Limit(-1),
+		NewQueryBuilder("select * from accounts").Offset(-10),
+	} {
+		if _, _, err := qb.Build(); !errors.Is(err, ErrInvalidLimit) {
+			t.Errorf("Expected ErrInvalidLimit, got %v", err)
+		}
+	}
+}
+
+func TestLimitPolicy_SharedOptions(t *testing.T) {
+	opts := []Option{WithDialect(SQLite), WithDefaultLimit(20), WithMaxLimit(500)}
+
+	result, _ := NewQueryBuilder("select * from accounts", opts...).SortBy(Sort("id")).Commit()
+
+	expected := "select * from accounts ORDER BY id LIMIT 20;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+
+	info := NewQueryBuilder("select * from accounts", opts...).Limit(1000).PageInfo(1200)
+	if info.PageSize != 500 || info.TotalPages != 3 {
+		t.Errorf("Expected page size 500 and 3 pages, got %+v", info)
+	}
+}
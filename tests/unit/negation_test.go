@@ -0,0 +1,119 @@
+package querybuilder_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/bolanosdev/query-builder"
+)
+
+func TestQueryBuilder_NotEqual(t *testing.T) {
+	query := "select * from accounts"
+	qb := NewQueryBuilder(query)
+	result, values := qb.Where(Not(ByIntColumn("id", []int{1}))).Commit()
+
+	expected := "select * from accounts WHERE id <> $1;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+
+	if len(values) != 1 {
+		t.Errorf("Expected 1 value, got %d", len(values))
+	}
+}
+
+func TestQueryBuilder_NotIn(t *testing.T) {
+	query := "select * from accounts"
+	qb := NewQueryBuilder(query)
+	result, values := qb.Where(Not(ByStringColumn("status", []string{"banned", "deleted"}))).Commit()
+
+	expected := "select * from accounts WHERE status NOT IN ($1, $2);"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+
+	if len(values) != 2 {
+		t.Errorf("Expected 2 values, got %d", len(values))
+	}
+}
+
+func TestQueryBuilder_NotLike(t *testing.T) {
+	query := "select * from accounts"
+	qb := NewQueryBuilder(query)
+	result, _ := qb.Where(
+		Not(ByStringColumn("name", []string{"car"}, StringContains)),
+		Not(ByStringColumn("email", []string{"TEST"}, StringStartsWith, NonSensitive)),
+	).Commit()
+
+	expected := "select * from accounts WHERE name NOT LIKE '%' || $1 || '%' ESCAPE '!' AND LOWER(email) NOT LIKE LOWER($2) || '%' ESCAPE '!';"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+}
+
+func TestQueryBuilder_NotCaseInsensitiveExact(t *testing.T) {
+	query := "select * from accounts"
+	qb := NewQueryBuilder(query)
+	result, _ := qb.Where(Not(ByStringColumn("name", []string{"Carlos"}, NonSensitive))).Commit()
+
+	expected := "select * from accounts WHERE LOWER(name) <> LOWER($1);"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+}
+
+func TestQueryBuilder_NotDateRange(t *testing.T) {
+	query := "select * from events"
+	qb := NewQueryBuilder(query)
+	after := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	before := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+	result, values := qb.Where(Not(ByDateColumn("created_at", Dates{After: after, Before: before}))).Commit()
+
+	expected := "select * from events WHERE NOT (created_at >= $1 AND created_at <= $2);"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+
+	if len(values) != 2 {
+		t.Errorf("Expected 2 values, got %d", len(values))
+	}
+}
+
+func TestQueryBuilder_NotGroup(t *testing.T) {
+	query := "select * from accounts"
+	qb := NewQueryBuilder(query)
+	result, _ := qb.Where(
+		ByIntColumn("active", []int{1}),
+		Not(Or(ByIntColumn("id", []int{1}), And(ByStringColumn("name", []string{"john"}), Not(ByIntColumn("id", []int{2}))))),
+	).Commit()
+
+	expected := "select * from accounts WHERE active = $1 AND NOT (id = $2 OR (name = $3 AND id <> $4));"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+}
+
+func TestQueryBuilder_DoubleNegation(t *testing.T) {
+	query := "select * from accounts"
+	qb := NewQueryBuilder(query)
+	result, _ := qb.Where(
+		Not(Not(ByIntColumn("id", []int{1, 2}))),
+		Not(Not(Or(ByIntColumn("id", []int{3}), ByIntColumn("id", []int{4})))),
+	).Commit()
+
+	expected := "select * from accounts WHERE id IN ($1, $2) AND (id = $3 OR id = $4);"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+}
+
+func TestQueryBuilder_NotEmptyCondition(t *testing.T) {
+	query := "select * from accounts"
+	qb := NewQueryBuilder(query)
+	result, _ := qb.Where(Not(ByIntColumn("id", nil)), Not(Or())).Commit()
+
+	expected := "select * from accounts;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+}
@@ -0,0 +1,94 @@
+package querybuilder_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/bolanosdev/query-builder"
+)
+
+func TestQueryBuilder_IsNull(t *testing.T) {
+	query := "select * from accounts"
+	qb := NewQueryBuilder(query)
+	result, values := qb.Where(IsNull("deleted_at")).Commit()
+
+	expected := "select * from accounts WHERE deleted_at IS NULL;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+
+	if len(values) != 0 {
+		t.Errorf("Expected 0 values, got %d", len(values))
+	}
+}
+
+func TestQueryBuilder_IsNotNull(t *testing.T) {
+	query := "select * from accounts"
+	qb := NewQueryBuilder(query)
+	result, _ := qb.Where(IsNotNull("verified_at"), Not(IsNotNull("deleted_at"))).Commit()
+
+	expected := "select * from accounts WHERE verified_at IS NOT NULL AND deleted_at IS NULL;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+}
+
+func TestQueryBuilder_BoolColumn(t *testing.T) {
+	query := "select * from accounts"
+	qb := NewQueryBuilder(query)
+	result, values := qb.Where(ByBoolColumn("is_active", true), ByBoolColumn("is_admin", false)).Commit()
+
+	expected := "select * from accounts WHERE is_active = TRUE AND is_admin = FALSE;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+
+	if len(values) != 0 {
+		t.Errorf("Expected 0 values, got %d", len(values))
+	}
+}
+
+func TestQueryBuilder_BoolColumnSQLServer(t *testing.T) {
+	query := "select * from accounts"
+	qb := NewQueryBuilder(query, WithDialect(SQLServer))
+	result, _ := qb.Where(ByBoolColumn("is_active", true), Not(ByBoolColumn("is_admin", true))).Commit()
+
+	expected := "select * from accounts WHERE is_active = 1 AND is_admin <> 1;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+}
+
+func TestQueryBuilder_ZeroArgumentConditionsKeepNumbering(t *testing.T) {
+	query := "select * from accounts"
+	qb := NewQueryBuilder(query)
+	result, values := qb.Where(
+		ByIntColumn("id", []int{1}),
+		IsNull("deleted_at"),
+		Or(ByBoolColumn("is_admin", true), ByStringColumn("role", []string{"owner", "editor"})),
+		ByStringColumn("name", []string{"carlos"}),
+	).Commit()
+
+	expected := "select * from accounts WHERE id = $1 AND deleted_at IS NULL AND (is_admin = TRUE OR role IN ($2, $3)) AND name = $4;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+
+	if len(values) != 4 || values[0] != 1 || values[3] != "carlos" {
+		t.Errorf("Expected [1 owner editor carlos], got %v", values)
+	}
+}
+
+func TestQueryBuilder_NullBoolInvalidColumn(t *testing.T) {
+	invalid := []QueryCondition{
+		IsNull("deleted_at;"),
+		IsNotNull("deleted_at;"),
+		ByBoolColumn("is_active;", true),
+	}
+
+	for _, cond := range invalid {
+		if !errors.Is(cond.Err(), ErrInvalidColumnName) {
+			t.Errorf("Expected ErrInvalidColumnName, got %v", cond.Err())
+		}
+	}
+}
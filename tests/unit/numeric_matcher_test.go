@@ -0,0 +1,128 @@
+package querybuilder_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	. "github.com/bolanosdev/query-builder"
+)
+
+func TestQueryBuilder_FloatSingleValue(t *testing.T) {
+	query := "select * from products"
+	qb := NewQueryBuilder(query)
+	result, values := qb.Where(ByFloatColumn("rating", []float64{4.5})).Commit()
+
+	expected := "select * from products WHERE rating = $1;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+
+	if len(values) != 1 || values[0] != 4.5 {
+		t.Errorf("Expected [4.5], got %v", values)
+	}
+}
+
+func TestQueryBuilder_FloatInClause(t *testing.T) {
+	query := "select * from products"
+	qb := NewQueryBuilder(query)
+	result, values := qb.Where(ByFloatColumn("rating", []float64{4.5, 5})).Commit()
+
+	expected := "select * from products WHERE rating IN ($1, $2);"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+
+	if len(values) != 2 {
+		t.Errorf("Expected 2 values, got %d", len(values))
+	}
+}
+
+func TestQueryBuilder_FloatComparisonAndRange(t *testing.T) {
+	low, high := -90.0, 90.0
+	query := "select * from places"
+	qb := NewQueryBuilder(query)
+	result, values := qb.Where(
+		ByFloatRange("lat", Range[float64]{Min: &low, Max: &high, MinExclusive: true}),
+		ByFloatComparison("rating", OpGte, 3.5),
+		ByFloatColumn("lng", nil),
+	).Commit()
+
+	expected := "select * from places WHERE lat > $1 AND lat <= $2 AND rating >= $3;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+
+	if len(values) != 3 || values[0] != -90.0 || values[2] != 3.5 {
+		t.Errorf("Expected [-90 90 3.5], got %v", values)
+	}
+}
+
+func TestQueryBuilder_DecimalStrings(t *testing.T) {
+	query := "select * from products"
+	qb := NewQueryBuilder(query)
+	result, values := qb.Where(
+		ByDecimalColumn("price", []string{"19.99", "29.99"}),
+		ByDecimalComparison("discount", OpLt, "0.25"),
+	).Commit()
+
+	expected := "select * from products WHERE price IN ($1, $2) AND discount < $3;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+
+	if len(values) != 3 || values[0] != "19.99" || values[2] != "0.25" {
+		t.Errorf("Expected [19.99 29.99 0.25], got %v", values)
+	}
+}
+
+func TestQueryBuilder_DecimalRats(t *testing.T) {
+	low := big.NewRat(1999, 100)
+	high := big.NewRat(100, 1)
+	query := "select * from products"
+	qb := NewQueryBuilder(query)
+	result, values := qb.Where(
+		ByDecimalColumn("price", []*big.Rat{big.NewRat(1, 8)}),
+		ByDecimalRange("total", Range[*big.Rat]{Min: &low, Max: &high}),
+	).Commit()
+
+	expected := "select * from products WHERE price = $1 AND total >= $2 AND total <= $3;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+
+	if len(values) != 3 || values[0] != "0.125" || values[1] != "19.99" || values[2] != "100" {
+		t.Errorf("Expected [0.125 19.99 100], got %v", values)
+	}
+}
+
+func TestQueryBuilder_DecimalInvalidValues(t *testing.T) {
+	invalid := []QueryCondition{
+		ByDecimalColumn("price", []string{"1e10"}),
+		ByDecimalColumn("price", []string{"abc"}),
+		ByDecimalComparison("price", OpEq, "1/3"),
+		ByDecimalColumn("price", []*big.Rat{big.NewRat(1, 3)}),
+		ByDecimalComparison("price", OpEq, (*big.Rat)(nil)),
+	}
+
+	for _, cond := range invalid {
+		_, _, err := NewQueryBuilder("select * from products").Where(cond).Build()
+		if !errors.Is(err, ErrUnsupportedValue) {
+			t.Errorf("Expected ErrUnsupportedValue, got %v", err)
+		}
+	}
+}
+
+func TestQueryBuilder_NumericInvalidColumn(t *testing.T) {
+	invalid := []QueryCondition{
+		ByFloatColumn("price;", []float64{1}),
+		ByFloatComparison("price;", OpEq, 1),
+		ByDecimalColumn("price;", []string{"1"}),
+	}
+
+	for _, cond := range invalid {
+		if !errors.Is(cond.Err(), ErrInvalidColumnName) {
+			t.Errorf("Expected ErrInvalidColumnName, got %v", cond.Err())
+		}
+	}
+}
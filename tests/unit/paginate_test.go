@@ -0,0 +1,111 @@
+package querybuilder_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	. "github.com/bolanosdev/query-builder"
+)
+
+func TestPaginate_LimitAndOffset(t *testing.T) {
+	cases := []struct {
+		page, size int
+		expected   string
+	}{
+		{1, 20, "select * from accounts LIMIT 20 OFFSET 0;"},
+		{3, 20, "select * from accounts LIMIT 20 OFFSET 40;"},
+		{0, 20, "select * from accounts LIMIT 20 OFFSET 0;"},
+		{-2, 20, "select * from accounts LIMIT 20 OFFSET 0;"},
+		{2, 0, "select * from accounts LIMIT 10 OFFSET 10;"},
+		{2, 500, "select * from accounts LIMIT 100 OFFSET 100;"},
+	}
+
+	for _, c := range cases {
+		result, _ := NewQueryBuilder("select * from accounts").Paginate(c.page, c.size).Commit()
+		if result != c.expected {
+			t.Errorf("Paginate(%d, %d): Expected: %s\nGot: %s", c.page, c.size, c.expected, result)
+		}
+	}
+}
+
+func TestPaginate_MaxPageSize(t *testing.T) {
+	result, _ := NewQueryBuilder("select * from accounts", WithMaxPageSize(25)).Paginate(2, 50).Commit()
+
+	expected := "select * from accounts LIMIT 25 OFFSET 25;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+}
+
+func TestPaginate_PageOutOfRange(t *testing.T) {
+	for _, page := range []int{math.MaxInt, math.MaxInt/20 + 2} {
+		_, _, err := NewQueryBuilder("select * from accounts").Paginate(page, 20).Build()
+		if !errors.Is(err, ErrInvalidLimit) {
+			t.Errorf("Paginate(%d, 20): Expected ErrInvalidLimit, got %v", page, err)
+		}
+	}
+
+	// The last page whose offset fits is still built
+	page := math.MaxInt/20 + 1
+	if _, _, err := NewQueryBuilder("select * from accounts").Paginate(page, 20).Build(); err != nil {
+		t.Errorf("Paginate(%d, 20): unexpected error %v", page, err)
+	}
+}
+
+func TestPaginate_Dialect(t *testing.T) {
+	result, _ := NewQueryBuilder("select * from accounts", WithDialect(SQLServer)).
+		SortBy(Sort("id")).
+		Paginate(3, 10).
+		Commit()
+
+	expected := "select * from accounts ORDER BY id OFFSET 20 ROWS FETCH NEXT 10 ROWS ONLY;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+}
+
+func TestPaginate_PageInfo(t *testing.T) {
+	cases := []struct {
+		page, size, total int
+		expected          PageInfo
+	}{
+		{1, 10, 45, PageInfo{Page: 1, PageSize: 10, Total: 45, TotalPages: 5, HasNext: true, HasPrev: false}},
+		{3, 10, 45, PageInfo{Page: 3, PageSize: 10, Total: 45, TotalPages: 5, HasNext: true, HasPrev: true}},
+		{5, 10, 45, PageInfo{Page: 5, PageSize: 10, Total: 45, TotalPages: 5, HasNext: false, HasPrev: true}},
+		{7, 10, 45, PageInfo{Page: 7, PageSize: 10, Total: 45, TotalPages: 5, HasNext: false, HasPrev: true}},
+		{1, 10, 0, PageInfo{Page: 1, PageSize: 10, Total: 0, TotalPages: 0, HasNext: false, HasPrev: false}},
+		{2, 0, 30, PageInfo{Page: 2, PageSize: 10, Total: 30, TotalPages: 3, HasNext: true, HasPrev: true}},
+	}
+
+	for _, c := range cases {
+		info := NewQueryBuilder("select * from accounts").Paginate(c.page, c.size).PageInfo(c.total)
+		if info != c.expected {
+			t.Errorf("Paginate(%d, %d).PageInfo(%d): Expected %+v, got %+v", c.page, c.size, c.total, c.expected, info)
+		}
+	}
+}
+
+func TestPaginate_PageInfoFromLimitOffset(t *testing.T) {
+	info := NewQueryBuilder("select * from accounts").Offset(20).PageInfo(25)
+
+	expected := PageInfo{Page: 3, PageSize: 10, Total: 25, TotalPages: 3, HasNext: false, HasPrev: true}
+	if info != expected {
+		t.Errorf("Expected %+v, got %+v", expected, info)
+	}
+
+	info = NewQueryBuilder("select * from accounts").PageInfo(25)
+
+	expected = PageInfo{Page: 1, PageSize: 25, Total: 25, TotalPages: 1, HasNext: false, HasPrev: false}
+	if info != expected {
+		t.Errorf("Expected %+v, got %+v", expected, info)
+	}
+
+	// A zero limit returns no rows, so there are no pages
+	info = NewQueryBuilder("select * from accounts").Limit(0).PageInfo(10)
+
+	expected = PageInfo{Page: 1, PageSize: 0, Total: 10, TotalPages: 0, HasNext: false, HasPrev: false}
+	if info != expected {
+		t.Errorf("Expected %+v, got %+v", expected, info)
+	}
+}
@@ -0,0 +1,92 @@
+package querybuilder_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	. "github.com/bolanosdev/query-builder"
+)
+
+var parseSortAllowed = map[string]string{
+	"created_at": "a.created_at",
+	"name":       "a.name",
+	"title":      Lower("a.title"),
+	"id":         "a.id",
+	"identifier": "a.id",
+}
+
+func buildSortSpec(t *testing.T, spec string) string {
+	t.Helper()
+
+	fields, err := ParseSort(spec, parseSortAllowed)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	result, _ := NewQueryBuilder("select * from accounts a").SortBy(fields...).Commit()
+	return result
+}
+
+func TestParseSort_Directions(t *testing.T) {
+	cases := map[string]string{
+		"-created_at,name":           "select * from accounts a ORDER BY a.created_at DESC, a.name;",
+		"created_at:desc,name:asc":   "select * from accounts a ORDER BY a.created_at DESC, a.name;",
+		"created_at:DESC, title":     "select * from accounts a ORDER BY a.created_at DESC, LOWER(a.title);",
+		"-created_at:desc":           "select * from accounts a ORDER BY a.created_at DESC;",
+		" name , ,-id ":              "select * from accounts a ORDER BY a.name, a.id DESC;",
+		"name,-name,name:desc,title": "select * from accounts a ORDER BY a.name, LOWER(a.title);",
+		"id,-identifier":             "select * from accounts a ORDER BY a.id;",
+		"":                           "select * from accounts a;",
+	}
+
+	for spec, expected := range cases {
+		if result := buildSortSpec(t, spec); result != expected {
+			t.Errorf("ParseSort(%q): Expected: %s\nGot: %s", spec, expected, result)
+		}
+	}
+}
+
+func TestParseSort_Errors(t *testing.T) {
+	cases := map[string]string{
+		"password":          `unknown field "password" (allowed: created_at, id, identifier, name, title)`,
+		"name:up":           `"name:up" has unknown direction "up"`,
+		"-name:asc":         `"-name:asc" has both "-" and ":asc"`,
+		"-":                 `"-" has no field name`,
+		":desc":             `":desc" has no field name`,
+		"name;DROP TABLE a": `unknown field "name;DROP TABLE a"`,
+	}
+
+	for spec, message := range cases {
+		fields, err := ParseSort(spec, parseSortAllowed)
+		if !errors.Is(err, ErrInvalidSort) {
+			t.Errorf("ParseSort(%q): Expected ErrInvalidSort, got %v", spec, err)
+			continue
+		}
+
+		if !strings.Contains(err.Error(), message) {
+			t.Errorf("ParseSort(%q): Expected error containing %s, got %v", spec, message, err)
+		}
+
+		if fields != nil {
+			t.Errorf("ParseSort(%q): Expected no fields on error, got %v", spec, fields)
+		}
+	}
+}
+
+func TestParseSort_ReportsAllErrors(t *testing.T) {
+	_, err := ParseSort("name,password,-secret,id:sideways", parseSortAllowed)
+
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok || len(joined.Unwrap()) != 3 {
+		t.Errorf("Expected 3 errors, got %v", err)
+	}
+}
+
+func TestParseSort_InvalidAllowedColumn(t *testing.T) {
+	_, err := ParseSort("name", map[string]string{"name": "name; DROP TABLE accounts"})
+
+	if !errors.Is(err, ErrInvalidSort) || !errors.Is(err, ErrInvalidColumnName) {
+		t.Errorf("Expected ErrInvalidSort wrapping ErrInvalidColumnName, got %v", err)
+	}
+}
@@ -0,0 +1,112 @@
+package querybuilder_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/bolanosdev/query-builder"
+)
+
+func TestQueryBuilder_Raw(t *testing.T) {
+	query := "select * from players"
+	qb := NewQueryBuilder(query)
+	result, values := qb.Where(
+		ByIntColumn("team_id", []int{3}),
+		Raw("score + bonus > ? AND region = ?", 100, "eu"),
+	).Commit()
+
+	expected := "select * from players WHERE team_id = $1 AND (score + bonus > $2 AND region = $3);"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+
+	if len(values) != 3 || values[1] != 100 || values[2] != "eu" {
+		t.Errorf("Expected [3 100 eu], got %v", values)
+	}
+}
+
+func TestQueryBuilder_RawInsideGroups(t *testing.T) {
+	query := "select * from players"
+	qb := NewQueryBuilder(query, WithDialect(SQLServer))
+	result, values := qb.Where(Or(
+		ByIntColumn("id", []int{1, 2}),
+		And(Raw("a = ? OR b = ?", 1, 2), Not(Raw("c BETWEEN ? AND ?", 5, 9))),
+	)).Commit()
+
+	expected := "select * from players WHERE (id IN (@p1, @p2) OR ((a = @p3 OR b = @p4) AND NOT (c BETWEEN @p5 AND @p6)));"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+
+	if len(values) != 6 {
+		t.Errorf("Expected 6 values, got %d", len(values))
+	}
+}
+
+func TestQueryBuilder_RawWithoutArguments(t *testing.T) {
+	query := "select * from players"
+	qb := NewQueryBuilder(query)
+	result, values := qb.Where(Raw("score > bonus"), ByIntColumn("id", []int{1})).Commit()
+
+	expected := "select * from players WHERE (score > bonus) AND id = $1;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+
+	if len(values) != 1 {
+		t.Errorf("Expected 1 value, got %d", len(values))
+	}
+}
+
+func TestQueryBuilder_RawSliceArgument(t *testing.T) {
+	query := "select * from players"
+	qb := NewQueryBuilder(query, WithDialect(MySQL))
+	result, values := qb.Where(Raw("id IN ? AND token = ?", []int64{4, 5, 6}, []byte("abc"))).Commit()
+
+	expected := "select * from players WHERE (id IN (?, ?, ?) AND token = ?);"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+
+	if len(values) != 4 || values[0] != int64(4) {
+		t.Errorf("Expected [4 5 6 abc], got %v", values)
+	}
+}
+
+func TestQueryBuilder_RawQuotesAndEscapes(t *testing.T) {
+	query := "select * from players"
+	qb := NewQueryBuilder(query)
+	result, values := qb.Where(Raw("note <> 'why?' AND data ?? 'key' AND price LIKE '100%' AND id = ?", 7)).Commit()
+
+	expected := "select * from players WHERE (note <> 'why?' AND data ? 'key' AND price LIKE '100%' AND id = $1);"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+
+	if len(values) != 1 || values[0] != 7 {
+		t.Errorf("Expected [7], got %v", values)
+	}
+}
+
+func TestQueryBuilder_RawArgumentCountMismatch(t *testing.T) {
+	_, _, err := NewQueryBuilder("select * from players").Where(Raw("a = ? AND b = ?", 1)).Build()
+	if !errors.Is(err, ErrArgumentCount) {
+		t.Errorf("Expected ErrArgumentCount, got %v", err)
+	}
+}
+
+func TestQueryBuilder_RawEmptySliceArgument(t *testing.T) {
+	_, _, err := NewQueryBuilder("select * from players").Where(Raw("id IN ?", []int{})).Build()
+	if !errors.Is(err, ErrUnsupportedValue) {
+		t.Errorf("Expected ErrUnsupportedValue, got %v", err)
+	}
+}
+
+func TestQueryBuilder_RawEmpty(t *testing.T) {
+	result, _ := NewQueryBuilder("select * from players").Where(Raw("  ")).Commit()
+
+	expected := "select * from players;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+}
@@ -0,0 +1,119 @@
+package querybuilder_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/bolanosdev/query-builder"
+)
+
+func TestSelect_SameOutputAsBaseQuery(t *testing.T) {
+	base, baseValues := NewQueryBuilder("SELECT * FROM accounts").
+		Where(ByIntColumn("id", []int{1, 2}), ByStringColumn("name", []string{"car"}, StringContains)).
+		SortBy(Sort("name", SortDesc)).
+		Limit(10).
+		Commit()
+
+	structured, structuredValues := Select().From("accounts").
+		Where(ByIntColumn("id", []int{1, 2}), ByStringColumn("name", []string{"car"}, StringContains)).
+		SortBy(Sort("name", SortDesc)).
+		Limit(10).
+		Commit()
+
+	if base != structured {
+		t.Errorf("Expected: %s\nGot: %s", base, structured)
+	}
+
+	if len(baseValues) != len(structuredValues) {
+		t.Errorf("Expected %v, got %v", baseValues, structuredValues)
+	}
+}
+
+func TestSelect_ColumnsAndAliases(t *testing.T) {
+	result, _ := Select("a.id", "a.name AS display_name", "a.*").
+		From("accounts AS a").
+		Where(ByIntColumn("a.id", []int{1})).
+		Commit()
+
+	expected := "SELECT a.id, a.name AS display_name, a.* FROM accounts AS a WHERE a.id = $1;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+}
+
+func TestSelect_QuotedIdentifiers(t *testing.T) {
+	result, _ := NewQueryBuilder("", WithDialect(MySQL), WithQuotedIdentifiers()).
+		Select("a.id", "a.name as display_name", "a.*").
+		From("accounts AS a").
+		Commit()
+
+	expected := "SELECT `a`.`id`, `a`.`name` AS `display_name`, `a`.* FROM `accounts` AS `a`;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+}
+
+func TestSelect_DefaultsToAllColumns(t *testing.T) {
+	result, _ := Select().From("accounts").Commit()
+
+	expected := "SELECT * FROM accounts;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+}
+
+func TestSelect_Fields(t *testing.T) {
+	allowed := map[string]string{
+		"id":     "accounts.id",
+		"name":   "name",
+		"author": "users.name",
+	}
+
+	result, _ := Select().
+		SelectFields([]string{"id", "name", "author", "name"}, allowed).
+		From("accounts").
+		Commit()
+
+	expected := "SELECT accounts.id AS id, name, users.name AS author FROM accounts;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+}
+
+func TestSelect_FieldsEmptyKeepsSelection(t *testing.T) {
+	result, _ := Select("id").SelectFields([]string{""}, map[string]string{"name": "name"}).From("accounts").Commit()
+
+	expected := "SELECT id FROM accounts;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+}
+
+func TestSelect_Errors(t *testing.T) {
+	allowed := map[string]string{"id": "id"}
+
+	cases := map[string]struct {
+		builder  *QueryBuilder
+		expected error
+	}{
+		"column":         {Select("id; DROP TABLE accounts").From("accounts"), ErrInvalidColumnName},
+		"alias":          {Select("id AS \"x\"").From("accounts"), ErrInvalidColumnName},
+		"dotted alias":   {Select("id AS a.b").From("accounts"), ErrInvalidColumnName},
+		"alias no AS":    {Select("id x").From("accounts"), ErrInvalidColumnName},
+		"table":          {Select("id").From("accounts--"), ErrInvalidColumnName},
+		"star table":     {Select("id").From("*"), ErrInvalidColumnName},
+		"function table": {Select().From("COUNT(x)"), ErrInvalidColumnName},
+		"function join":  {Select().From("accounts").Join("MAX(users)", On("a", "b")), ErrInvalidColumnName},
+		"unknown field":  {Select().SelectFields([]string{"password"}, allowed).From("accounts"), ErrInvalidColumnName},
+		"missing From":   {Select("id"), ErrInvalidStatement},
+		"invalid column": {Select().SelectFields([]string{"id"}, map[string]string{"id": "id)"}).From("accounts"), ErrInvalidColumnName},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, _, err := c.builder.Build(); !errors.Is(err, c.expected) {
+				t.Errorf("Expected %v, got %v", c.expected, err)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package querybuilder_test
 
 import (
+	"errors"
 	"testing"
 
 	. "github.com/bolanosdev/query-builder"
@@ -100,3 +101,97 @@ func TestQueryBuilder_CompleteQuery(t *testing.T) {
 		t.Errorf("Expected 3 values, got %d", len(values))
 	}
 }
+
+func TestQueryBuilder_SortNulls(t *testing.T) {
+	cases := map[Dialect]string{
+		Postgres:  "select * from accounts ORDER BY deleted_at DESC NULLS LAST, name NULLS FIRST;",
+		SQLite:    "select * from accounts ORDER BY deleted_at DESC NULLS LAST, name NULLS FIRST;",
+		MySQL:     "select * from accounts ORDER BY CASE WHEN deleted_at IS NULL THEN 1 ELSE 0 END, deleted_at DESC, CASE WHEN name IS NULL THEN 0 ELSE 1 END, name;",
+		SQLServer: "select * from accounts ORDER BY CASE WHEN deleted_at IS NULL THEN 1 ELSE 0 END, deleted_at DESC, CASE WHEN name IS NULL THEN 0 ELSE 1 END, name;",
+	}
+
+	for dialect, expected := range cases {
+		result, _ := NewQueryBuilder("select * from accounts", WithDialect(dialect)).
+			SortBy(Sort("deleted_at", SortDesc).NullsLast(), Sort("name").NullsFirst()).
+			Commit()
+
+		if result != expected {
+			t.Errorf("Expected: %s\nGot: %s", expected, result)
+		}
+	}
+}
+
+func TestQueryBuilder_SortLower(t *testing.T) {
+	result, _ := NewQueryBuilder("select * from accounts", WithQuotedIdentifiers()).
+		SortBy(Sort(Lower("a.name"), SortDesc), Sort("id")).
+		Commit()
+
+	expected := `select * from accounts ORDER BY LOWER("a"."name") DESC, "id";`
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+}
+
+func TestQueryBuilder_SortPriority(t *testing.T) {
+	result, values := NewQueryBuilder("select * from tickets").
+		Where(ByIntColumn("team_id", []int{3})).
+		SortBy(SortPriority("status", []string{"urgent", "open"}), Sort("created_at", SortDesc)).
+		Limit(20).
+		Commit()
+
+	expected := "select * from tickets WHERE team_id = $1 ORDER BY CASE status WHEN $2 THEN 0 WHEN $3 THEN 1 ELSE 2 END, created_at DESC LIMIT 20;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+
+	if len(values) != 3 || values[1] != "urgent" || values[2] != "open" {
+		t.Errorf("Expected [3 urgent open], got %v", values)
+	}
+}
+
+func TestQueryBuilder_SortPriorityNullsEmulated(t *testing.T) {
+	result, values := NewQueryBuilder("select * from tickets", WithDialect(SQLServer)).
+		SortBy(SortPriority("priority", []int{1, 2}, SortDesc).NullsLast()).
+		Commit()
+
+	expected := "select * from tickets ORDER BY CASE WHEN CASE priority WHEN @p1 THEN 0 WHEN @p2 THEN 1 ELSE 2 END IS NULL THEN 1 ELSE 0 END, CASE priority WHEN @p3 THEN 0 WHEN @p4 THEN 1 ELSE 2 END DESC;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+
+	if len(values) != 4 {
+		t.Errorf("Expected 4 values, got %v", values)
+	}
+}
+
+func TestQueryBuilder_SortExpressionErrors(t *testing.T) {
+	cases := map[string]struct {
+		field    SortField
+		expected error
+	}{
+		"lower column":    {Sort(Lower("name) DESC; --")), ErrInvalidColumnName},
+		"other function":  {Sort("RANDOM()"), ErrInvalidColumnName},
+		"raw expression":  {Sort("CASE WHEN x THEN 1 END"), ErrInvalidColumnName},
+		"priority column": {SortPriority("status;", []string{"open"}), ErrInvalidColumnName},
+		"priority values": {SortPriority("status", []string{}), ErrUnsupportedValue},
+		"nulls keep err":  {Sort("id--").NullsLast(), ErrInvalidColumnName},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, _, err := NewQueryBuilder("select * from accounts").SortBy(c.field).Build()
+			if !errors.Is(err, c.expected) {
+				t.Errorf("Expected %v, got %v", c.expected, err)
+			}
+		})
+	}
+}
+
+func TestQueryBuilder_SortKeysetRejectsExpressions(t *testing.T) {
+	for _, field := range []SortField{Sort("deleted_at").NullsLast(), SortPriority("status", []string{"open"})} {
+		_, _, err := NewQueryBuilder("select * from accounts").SortBy(field, Sort("id")).After("x", 1).Build()
+		if !errors.Is(err, ErrInvalidStatement) {
+			t.Errorf("Expected ErrInvalidStatement, got %v", err)
+		}
+	}
+}
@@ -51,7 +51,7 @@ func TestQueryBuilder_StringContains(t *testing.T) {
 	qb := NewQueryBuilder(query)
 	result, values := qb.Where(ByStringColumn("name", []string{"car"}, StringContains)).Commit()
 
-	expected := "select * from accounts WHERE name LIKE '%' || $1 || '%';"
+	expected := "select * from accounts WHERE name LIKE '%' || $1 || '%' ESCAPE '!';"
 	if result != expected {
 		t.Errorf("Expected: %s\nGot: %s", expected, result)
 	}
@@ -66,7 +66,7 @@ func TestQueryBuilder_StringStartsWith(t *testing.T) {
 	qb := NewQueryBuilder(query)
 	result, values := qb.Where(ByStringColumn("name", []string{"car"}, StringStartsWith)).Commit()
 
-	expected := "select * from accounts WHERE name LIKE $1 || '%';"
+	expected := "select * from accounts WHERE name LIKE $1 || '%' ESCAPE '!';"
 	if result != expected {
 		t.Errorf("Expected: %s\nGot: %s", expected, result)
 	}
@@ -81,7 +81,7 @@ func TestQueryBuilder_StringEndsWith(t *testing.T) {
 	qb := NewQueryBuilder(query)
 	result, values := qb.Where(ByStringColumn("name", []string{"los"}, StringEndsWith)).Commit()
 
-	expected := "select * from accounts WHERE name LIKE '%' || $1;"
+	expected := "select * from accounts WHERE name LIKE '%' || $1 ESCAPE '!';"
 	if result != expected {
 		t.Errorf("Expected: %s\nGot: %s", expected, result)
 	}
@@ -111,7 +111,7 @@ func TestQueryBuilder_StringContainsCaseInsensitive(t *testing.T) {
 	qb := NewQueryBuilder(query)
 	result, values := qb.Where(ByStringColumn("name", []string{"CAR"}, StringContains, NonSensitive)).Commit()
 
-	expected := "select * from accounts WHERE LOWER(name) LIKE '%' || LOWER($1) || '%';"
+	expected := "select * from accounts WHERE LOWER(name) LIKE '%' || LOWER($1) || '%' ESCAPE '!';"
 	if result != expected {
 		t.Errorf("Expected: %s\nGot: %s", expected, result)
 	}
@@ -126,7 +126,7 @@ func TestQueryBuilder_StringStartsWithCaseInsensitive(t *testing.T) {
 	qb := NewQueryBuilder(query)
 	result, values := qb.Where(ByStringColumn("name", []string{"CAR"}, StringStartsWith, NonSensitive)).Commit()
 
-	expected := "select * from accounts WHERE LOWER(name) LIKE LOWER($1) || '%';"
+	expected := "select * from accounts WHERE LOWER(name) LIKE LOWER($1) || '%' ESCAPE '!';"
 	if result != expected {
 		t.Errorf("Expected: %s\nGot: %s", expected, result)
 	}
@@ -141,7 +141,7 @@ func TestQueryBuilder_StringEndsWithCaseInsensitive(t *testing.T) {
 	qb := NewQueryBuilder(query)
 	result, values := qb.Where(ByStringColumn("name", []string{"LOS"}, StringEndsWith, NonSensitive)).Commit()
 
-	expected := "select * from accounts WHERE LOWER(name) LIKE '%' || LOWER($1);"
+	expected := "select * from accounts WHERE LOWER(name) LIKE '%' || LOWER($1) ESCAPE '!';"
 	if result != expected {
 		t.Errorf("Expected: %s\nGot: %s", expected, result)
 	}
@@ -150,3 +150,65 @@ func TestQueryBuilder_StringEndsWithCaseInsensitive(t *testing.T) {
 		t.Errorf("Expected value 'LOS', got %v", values)
 	}
 }
+
+func TestQueryBuilder_StringLikeEscapesWildcards(t *testing.T) {
+	query := "select * from products"
+	qb := NewQueryBuilder(query)
+	result, values := qb.Where(ByStringColumn("discount", []string{"50%_off!"}, StringContains)).Commit()
+
+	expected := "select * from products WHERE discount LIKE '%' || $1 || '%' ESCAPE '!';"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+
+	if len(values) != 1 || values[0] != "50!%!_off!!" {
+		t.Errorf("Expected value '50!%%!_off!!', got %v", values)
+	}
+}
+
+func TestQueryBuilder_StringLikeEscapesBracketOnSQLServer(t *testing.T) {
+	qb := NewQueryBuilder("select * from products", WithDialect(SQLServer))
+	result, values := qb.Where(ByStringColumn("sku", []string{"a[b]c"}, StringContains)).Commit()
+
+	expected := "select * from products WHERE sku LIKE '%' + @p1 + '%' ESCAPE '!';"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+
+	if len(values) != 1 || values[0] != "a![b]c" {
+		t.Errorf("Expected value 'a![b]c', got %v", values)
+	}
+}
+
+func TestQueryBuilder_StringExactNotEscaped(t *testing.T) {
+	query := "select * from products"
+	qb := NewQueryBuilder(query)
+	result, values := qb.Where(ByStringColumn("code", []string{"50%"})).Commit()
+
+	expected := "select * from products WHERE code = $1;"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+
+	if len(values) != 1 || values[0] != "50%" {
+		t.Errorf("Expected value '50%%', got %v", values)
+	}
+}
+
+func TestQueryBuilder_StringRawPattern(t *testing.T) {
+	query := "select * from accounts"
+	qb := NewQueryBuilder(query)
+	result, values := qb.Where(
+		ByStringColumn("name", []string{"j_n%"}, StringStartsWith, PatternRaw),
+		ByStringColumn("email", []string{"%@example.com"}, StringOpts{Match: StringEndsWith, Sensitivity: NonSensitive, Pattern: PatternRaw}),
+	).Commit()
+
+	expected := "select * from accounts WHERE name LIKE $1 || '%' AND LOWER(email) LIKE '%' || LOWER($2);"
+	if result != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, result)
+	}
+
+	if len(values) != 2 || values[0] != "j_n%" || values[1] != "%@example.com" {
+		t.Errorf("Expected raw values, got %v", values)
+	}
+}
@@ -0,0 +1,135 @@
+package querybuilder_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/bolanosdev/query-builder"
+)
+
+func TestUpdate_SetAndWhere(t *testing.T) {
+	query, values, err := Update("accounts").
+		Set("name", "carlos").
+		Set("active", true).
+		Where(ByIntColumn("id", []int{1, 2}), ByStringColumn("role", []string{"admin"})).
+		Build()
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := "UPDATE accounts SET name = $1, active = $2 WHERE id IN ($3, $4) AND role = $5;"
+	if query != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, query)
+	}
+
+	if len(values) != 5 || values[0] != "carlos" || values[2] != 1 || values[4] != "admin" {
+		t.Errorf("Expected [carlos true 1 2 admin], got %v", values)
+	}
+}
+
+func TestUpdate_GroupsAndOrWhere(t *testing.T) {
+	query, _ := Update("accounts").
+		Set("status", "archived").
+		Where(Or(ByIntColumn("id", []int{1}), Raw("last_login < ?", "2024-01-01"))).
+		OrWhere(IsNull("last_login")).
+		MustBuild()
+
+	expected := "UPDATE accounts SET status = $1 WHERE (id = $2 OR (last_login < $3)) OR last_login IS NULL;"
+	if query != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, query)
+	}
+}
+
+func TestUpdate_Returning(t *testing.T) {
+	cases := map[Dialect]string{
+		Postgres:  "UPDATE accounts SET name = $1 WHERE id = $2 RETURNING id, name;",
+		SQLite:    "UPDATE accounts SET name = ? WHERE id = ? RETURNING id, name;",
+		SQLServer: "UPDATE accounts SET name = @p1 OUTPUT INSERTED.id, INSERTED.name WHERE id = @p2;",
+	}
+
+	for dialect, expected := range cases {
+		query, _ := Update("accounts", WithDialect(dialect)).
+			Set("name", "carlos").
+			Where(ByIntColumn("id", []int{1})).
+			Returning("id", "name").
+			MustBuild()
+
+		if query != expected {
+			t.Errorf("Expected: %s\nGot: %s", expected, query)
+		}
+	}
+}
+
+func TestUpdate_QuotedIdentifiers(t *testing.T) {
+	query, _ := Update("accounts", WithDialect(MySQL), WithQuotedIdentifiers()).
+		Set("name", "carlos").
+		Where(ByIntColumn("id", []int{1})).
+		MustBuild()
+
+	expected := "UPDATE `accounts` SET `name` = ? WHERE `id` = ?;"
+	if query != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, query)
+	}
+}
+
+func TestUpdate_MissingWhere(t *testing.T) {
+	_, _, err := Update("accounts").Set("active", false).Build()
+	if !errors.Is(err, ErrMissingWhere) {
+		t.Errorf("Expected ErrMissingWhere, got %v", err)
+	}
+
+	// Empty conditions are optional filters and do not count as a WHERE clause
+	_, _, err = Update("accounts").Set("active", false).Where(ByIntColumn("id", []int{})).Build()
+	if !errors.Is(err, ErrMissingWhere) {
+		t.Errorf("Expected ErrMissingWhere for empty conditions, got %v", err)
+	}
+}
+
+func TestUpdate_AllRows(t *testing.T) {
+	query, values, err := Update("accounts").Set("active", false).AllRows().Build()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := "UPDATE accounts SET active = $1;"
+	if query != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, query)
+	}
+
+	if len(values) != 1 {
+		t.Errorf("Expected 1 value, got %d", len(values))
+	}
+}
+
+func TestUpdate_Errors(t *testing.T) {
+	cases := map[string]struct {
+		builder  *UpdateBuilder
+		expected error
+	}{
+		"table":     {Update("accounts;").Set("name", "x").AllRows(), ErrInvalidColumnName},
+		"set":       {Update("accounts").Set("name = 'x' --", "x").AllRows(), ErrInvalidColumnName},
+		"where":     {Update("accounts").Set("name", "x").Where(ByIntColumn("id)", []int{1})), ErrInvalidColumnName},
+		"no sets":   {Update("accounts").Where(ByIntColumn("id", []int{1})), ErrInvalidStatement},
+		"returning": {Update("accounts", WithDialect(MySQL)).Set("name", "x").AllRows().Returning("id"), ErrUnsupportedByDialect},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, _, err := c.builder.Build(); !errors.Is(err, c.expected) {
+				t.Errorf("Expected %v, got %v", c.expected, err)
+			}
+		})
+	}
+}
+
+func TestUpdate_BuildIsRepeatable(t *testing.T) {
+	ub := Update("accounts").Set("name", "carlos").Where(ByIntColumn("id", []int{1}))
+
+	first, _ := ub.MustBuild()
+	second, values := ub.MustBuild()
+
+	if first != second || len(values) != 2 {
+		t.Errorf("Expected identical builds, got %s and %s (%v)", first, second, values)
+	}
+}
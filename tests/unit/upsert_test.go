@@ -0,0 +1,141 @@
+package querybuilder_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/bolanosdev/query-builder"
+)
+
+func TestUpsert_DoNothing(t *testing.T) {
+	query, values, err := Insert("accounts").
+		Columns("id", "name").
+		Values(1, "carlos").
+		OnConflict("id").
+		DoNothing().
+		Build()
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := "INSERT INTO accounts (id, name) VALUES ($1, $2) ON CONFLICT (id) DO NOTHING;"
+	if query != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, query)
+	}
+
+	if len(values) != 2 {
+		t.Errorf("Expected 2 values, got %d", len(values))
+	}
+}
+
+func TestUpsert_DoNothingWithoutTarget(t *testing.T) {
+	query, _ := Insert("accounts", WithDialect(SQLite)).
+		Columns("id").
+		Values(1).
+		DoNothing().
+		MustBuild()
+
+	expected := "INSERT INTO accounts (id) VALUES (?) ON CONFLICT DO NOTHING;"
+	if query != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, query)
+	}
+}
+
+func TestUpsert_DoUpdate(t *testing.T) {
+	query, _ := Insert("accounts").
+		Columns("email", "name", "updated_at").
+		Values("c@example.com", "carlos", "2024-01-01").
+		OnConflict("email").
+		DoUpdate("name", "updated_at").
+		Returning("id").
+		MustBuild()
+
+	expected := "INSERT INTO accounts (email, name, updated_at) VALUES ($1, $2, $3) ON CONFLICT (email) DO UPDATE SET name = EXCLUDED.name, updated_at = EXCLUDED.updated_at RETURNING id;"
+	if query != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, query)
+	}
+}
+
+func TestUpsert_DoUpdateWhere(t *testing.T) {
+	query, values := Insert("accounts").
+		Columns("id", "name").
+		Values(1, "carlos").
+		Values(2, "john").
+		OnConflict("id").
+		DoUpdate("name").
+		DoUpdateWhere(Not(ByBoolColumn("accounts.locked", true)), ByStringColumn("accounts.role", []string{"user"})).
+		MustBuild()
+
+	expected := "INSERT INTO accounts (id, name) VALUES ($1, $2), ($3, $4) ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name WHERE accounts.locked <> TRUE AND accounts.role = $5;"
+	if query != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, query)
+	}
+
+	if len(values) != 5 || values[4] != "user" {
+		t.Errorf("Expected the DO UPDATE value last, got %v", values)
+	}
+}
+
+func TestUpsert_QuotedIdentifiers(t *testing.T) {
+	query, _ := Insert("accounts", WithQuotedIdentifiers()).
+		Columns("id", "name").
+		Values(1, "carlos").
+		OnConflict("id").
+		DoUpdate("name").
+		MustBuild()
+
+	expected := `INSERT INTO "accounts" ("id", "name") VALUES ($1, $2) ON CONFLICT ("id") DO UPDATE SET "name" = EXCLUDED."name";`
+	if query != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, query)
+	}
+}
+
+func TestUpsert_BatchesReserveConditionParameters(t *testing.T) {
+	batches, err := Insert("accounts", WithMaxParams(5)).
+		Columns("id", "name").
+		Values(1, "carlos").
+		Values(2, "john").
+		Values(3, "jane").
+		OnConflict("id").
+		DoUpdate("name").
+		DoUpdateWhere(ByStringColumn("accounts.role", []string{"user"})).
+		BuildBatches()
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// 4 parameters remain for rows after the DO UPDATE condition, so 2 rows per batch
+	expected := "INSERT INTO accounts (id, name) VALUES ($1, $2), ($3, $4) ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name WHERE accounts.role = $5;"
+	if len(batches) != 2 || batches[0].Query != expected || len(batches[1].Values) != 3 {
+		t.Errorf("Expected 2 batches starting with %s, got %v", expected, batches)
+	}
+}
+
+func TestUpsert_Errors(t *testing.T) {
+	cases := map[string]struct {
+		builder  *InsertBuilder
+		expected error
+	}{
+		"target":            {Insert("accounts").Columns("id").Values(1).OnConflict("id)").DoNothing(), ErrInvalidColumnName},
+		"update column":     {Insert("accounts").Columns("id").Values(1).OnConflict("id").DoUpdate("name = 1"), ErrInvalidColumnName},
+		"qualified target":  {Insert("accounts").Columns("id").Values(1).OnConflict("accounts.id").DoNothing(), ErrInvalidColumnName},
+		"qualified update":  {Insert("accounts").Columns("id").Values(1).OnConflict("id").DoUpdate("t.a"), ErrInvalidColumnName},
+		"update condition":  {Insert("accounts").Columns("id").Values(1).OnConflict("id").DoUpdate("id").DoUpdateWhere(IsNull("x;")), ErrInvalidColumnName},
+		"no action":         {Insert("accounts").Columns("id").Values(1).OnConflict("id"), ErrInvalidStatement},
+		"update no target":  {Insert("accounts").Columns("id").Values(1).DoUpdate("id"), ErrInvalidStatement},
+		"update no columns": {Insert("accounts").Columns("id").Values(1).OnConflict("id").DoUpdate(), ErrInvalidStatement},
+		"where no update":   {Insert("accounts").Columns("id").Values(1).DoNothing().DoUpdateWhere(IsNull("x")), ErrInvalidStatement},
+		"mysql":             {Insert("accounts", WithDialect(MySQL)).Columns("id").Values(1).DoNothing(), ErrUnsupportedByDialect},
+		"sql server":        {Insert("accounts", WithDialect(SQLServer)).Columns("id").Values(1).OnConflict("id").DoUpdate("id"), ErrUnsupportedByDialect},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, _, err := c.builder.Build(); !errors.Is(err, c.expected) {
+				t.Errorf("Expected %v, got %v", c.expected, err)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package querybuilder_test
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -8,43 +9,115 @@ import (
 )
 
 func TestValidation_InvalidColumnName_IntColumn(t *testing.T) {
-	defer func() {
-		if r := recover(); r == nil {
-			t.Errorf("Expected panic for invalid column name")
-		}
-	}()
+	_, _, err := NewQueryBuilder("select * from users").
+		Where(ByIntColumn("id; DROP TABLE users--", []int{1})).
+		Build()
 
-	ByIntColumn("id; DROP TABLE users--", []int{1})
+	if !errors.Is(err, ErrInvalidColumnName) {
+		t.Errorf("Expected ErrInvalidColumnName, got %v", err)
+	}
 }
 
 func TestValidation_InvalidColumnName_StringColumn(t *testing.T) {
-	defer func() {
-		if r := recover(); r == nil {
-			t.Errorf("Expected panic for invalid column name")
-		}
-	}()
+	_, _, err := NewQueryBuilder("select * from users").
+		Where(ByStringColumn("name' OR '1'='1", []string{"john"}, StringOpts{})).
+		Build()
 
-	ByStringColumn("name' OR '1'='1", []string{"john"}, StringOpts{})
+	if !errors.Is(err, ErrInvalidColumnName) {
+		t.Errorf("Expected ErrInvalidColumnName, got %v", err)
+	}
 }
 
 func TestValidation_InvalidColumnName_DateColumn(t *testing.T) {
-	defer func() {
-		if r := recover(); r == nil {
-			t.Errorf("Expected panic for invalid column name")
-		}
-	}()
+	_, _, err := NewQueryBuilder("select * from users").
+		Where(ByDateColumn("created_at/*comment*/", Dates{After: time.Now()})).
+		Build()
 
-	ByDateColumn("created_at/*comment*/", Dates{After: time.Now()})
+	if !errors.Is(err, ErrInvalidColumnName) {
+		t.Errorf("Expected ErrInvalidColumnName, got %v", err)
+	}
 }
 
 func TestValidation_InvalidColumnName_SortField(t *testing.T) {
+	_, _, err := NewQueryBuilder("select * from users").
+		SortBy(Sort("id WHERE 1=1")).
+		Build()
+
+	if !errors.Is(err, ErrInvalidColumnName) {
+		t.Errorf("Expected ErrInvalidColumnName, got %v", err)
+	}
+}
+
+func TestValidation_InvalidColumnName_InsideGroup(t *testing.T) {
+	query, values, err := NewQueryBuilder("select * from users").
+		Where(Or(ByIntColumn("id", []int{1}), ByStringColumn("name;--", []string{"john"}))).
+		Build()
+
+	if !errors.Is(err, ErrInvalidColumnName) {
+		t.Errorf("Expected ErrInvalidColumnName, got %v", err)
+	}
+
+	if query != "" || values != nil {
+		t.Errorf("Expected empty query and values on error, got %q %v", query, values)
+	}
+}
+
+func TestValidation_UnsupportedStringOption(t *testing.T) {
+	_, _, err := NewQueryBuilder("select * from users").
+		Where(ByStringColumn("name", []string{"john"}, "contains")).
+		Build()
+
+	if !errors.Is(err, ErrUnsupportedValue) {
+		t.Errorf("Expected ErrUnsupportedValue, got %v", err)
+	}
+}
+
+func TestValidation_CollectsAllErrors(t *testing.T) {
+	qb := NewQueryBuilder("select * from users").
+		Where(ByIntColumn("id--", []int{1}), ByStringColumn("name", []string{"john"})).
+		SortBy(Sort("name;"))
+
+	_, _, err := qb.Build()
+	if err == nil {
+		t.Fatal("Expected an error")
+	}
+
+	if joined, ok := err.(interface{ Unwrap() []error }); !ok || len(joined.Unwrap()) != 2 {
+		t.Errorf("Expected 2 errors, got %v", err)
+	}
+
+	if qb.Err() == nil {
+		t.Error("Expected Err to report collected errors")
+	}
+}
+
+func TestValidation_MustBuildPanics(t *testing.T) {
 	defer func() {
 		if r := recover(); r == nil {
 			t.Errorf("Expected panic for invalid column name")
 		}
 	}()
 
-	Sort("id WHERE 1=1")
+	NewQueryBuilder("select * from users").Where(ByIntColumn("id; DROP TABLE users--", []int{1})).MustBuild()
+}
+
+func TestValidation_BuildWithoutErrors(t *testing.T) {
+	query, values, err := NewQueryBuilder("select * from users").
+		Where(ByIntColumn("id", []int{1})).
+		Build()
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := "select * from users WHERE id = $1;"
+	if query != expected {
+		t.Errorf("Expected: %s\nGot: %s", expected, query)
+	}
+
+	if len(values) != 1 {
+		t.Errorf("Expected 1 value, got %d", len(values))
+	}
 }
 
 func TestValidation_ValidColumnNames(t *testing.T) {
@@ -58,10 +131,14 @@ func TestValidation_ValidColumnNames(t *testing.T) {
 	}
 
 	for _, col := range validColumns {
-		// Should not panic
-		ByIntColumn(col, []int{1})
-		ByStringColumn(col, []string{"value"}, StringOpts{})
-		Sort(col)
+		_, _, err := NewQueryBuilder("select * from users").
+			Where(ByIntColumn(col, []int{1}), ByStringColumn(col, []string{"value"}, StringOpts{})).
+			SortBy(Sort(col)).
+			Build()
+
+		if err != nil {
+			t.Errorf("Unexpected error for valid column name %s: %v", col, err)
+		}
 	}
 }
 
@@ -80,30 +157,22 @@ func TestValidation_InvalidColumnNames(t *testing.T) {
 
 	for _, col := range invalidColumns {
 		t.Run("IntColumn_"+col, func(t *testing.T) {
-			defer func() {
-				if r := recover(); r == nil {
-					t.Errorf("Expected panic for invalid column name: %s", col)
-				}
-			}()
-			ByIntColumn(col, []int{1})
+			if err := ByIntColumn(col, []int{1}).Err(); !errors.Is(err, ErrInvalidColumnName) {
+				t.Errorf("Expected error for invalid column name: %s", col)
+			}
 		})
 
 		t.Run("StringColumn_"+col, func(t *testing.T) {
-			defer func() {
-				if r := recover(); r == nil {
-					t.Errorf("Expected panic for invalid column name: %s", col)
-				}
-			}()
-			ByStringColumn(col, []string{"value"}, StringOpts{})
+			if err := ByStringColumn(col, []string{"value"}, StringOpts{}).Err(); !errors.Is(err, ErrInvalidColumnName) {
+				t.Errorf("Expected error for invalid column name: %s", col)
+			}
 		})
 
 		t.Run("SortField_"+col, func(t *testing.T) {
-			defer func() {
-				if r := recover(); r == nil {
-					t.Errorf("Expected panic for invalid column name: %s", col)
-				}
-			}()
-			Sort(col)
+			_, _, err := NewQueryBuilder("select * from users").SortBy(Sort(col)).Build()
+			if !errors.Is(err, ErrInvalidColumnName) {
+				t.Errorf("Expected error for invalid column name: %s", col)
+			}
 		})
 	}
 }